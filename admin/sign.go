@@ -38,6 +38,11 @@ type CallArgs struct {
 	Method    string   `json:"method"`
 	Params    []string `json:"params"`
 	Timestamp int64    `json:"timestamp"`
+
+	// the admin signed tx of this call, not encoded into tx data
+	RawTx string `json:"-"`
+	// the admin signed txs approving the proposal of this call
+	ApproveTxs []string `json:"-"`
 }
 
 // Sign sign
@@ -101,10 +106,7 @@ func decodeCallArgs(data []byte) (*CallArgs, error) {
 
 // VerifyTransaction get sender
 func VerifyTransaction(tx *types.Transaction) (*common.Address, *CallArgs, error) {
-	if tx.To() == nil || *tx.To() != adminToAddr {
-		return nil, nil, errors.New("wrong admin tx to address")
-	}
-	args, err := decodeCallArgs(tx.Data())
+	sender, args, err := VerifySignature(tx)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -116,6 +118,19 @@ func VerifyTransaction(tx *types.Transaction) (*common.Address, *CallArgs, error
 	if now+maxFutureSeconds < timestamp {
 		return nil, nil, errors.New("future admin tx timestamp")
 	}
+	return sender, args, nil
+}
+
+// VerifySignature get sender without checking timestamp,
+// used to verify admin tx attached to later processing (eg. manual payout)
+func VerifySignature(tx *types.Transaction) (*common.Address, *CallArgs, error) {
+	if tx.To() == nil || *tx.To() != adminToAddr {
+		return nil, nil, errors.New("wrong admin tx to address")
+	}
+	args, err := decodeCallArgs(tx.Data())
+	if err != nil {
+		return nil, nil, err
+	}
 	sender, err := adminSigner.Sender(tx) // will verify signature
 	if err != nil {
 		return nil, nil, err
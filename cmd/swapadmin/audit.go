@@ -0,0 +1,91 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/anyswap/CrossChain-Bridge/cmd/utils"
+	"github.com/anyswap/CrossChain-Bridge/log"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	auditSignerFlag = &cli.StringFlag{
+		Name:  "signer",
+		Usage: "filter by signer address",
+	}
+	auditMethodFlag = &cli.StringFlag{
+		Name:  "method",
+		Usage: "filter by admin method",
+	}
+	auditFromFlag = &cli.Int64Flag{
+		Name:  "from",
+		Usage: "filter by call time from (unix seconds, inclusive)",
+	}
+	auditToFlag = &cli.Int64Flag{
+		Name:  "to",
+		Usage: "filter by call time to (unix seconds, exclusive)",
+	}
+	auditLimitFlag = &cli.IntFlag{
+		Name:  "limit",
+		Usage: "max number of records",
+		Value: 20,
+	}
+
+	auditCommand = &cli.Command{
+		Action: audit,
+		Name:   "audit",
+		Usage:  "query admin call audit log",
+		Description: `
+query the latest admin calls recorded by server,
+including signer, method, params, result and timestamp.
+`,
+		Flags: append([]cli.Flag{
+			auditSignerFlag,
+			auditMethodFlag,
+			auditFromFlag,
+			auditToFlag,
+			auditLimitFlag,
+		}, commonAdminFlags...),
+	}
+)
+
+func audit(ctx *cli.Context) error {
+	utils.SetLogger(ctx)
+	method := "audit"
+	if ctx.NArg() != 0 {
+		_ = cli.ShowCommandHelp(ctx, method)
+		fmt.Println()
+		return fmt.Errorf("invalid arguments: %q", ctx.Args())
+	}
+
+	err := prepare(ctx)
+	if err != nil {
+		return err
+	}
+
+	var params []string
+	if signer := ctx.String(auditSignerFlag.Name); signer != "" {
+		params = append(params, "signer="+signer)
+	}
+	if adminMethod := ctx.String(auditMethodFlag.Name); adminMethod != "" {
+		params = append(params, "method="+adminMethod)
+	}
+	if from := ctx.Int64(auditFromFlag.Name); from != 0 {
+		params = append(params, fmt.Sprintf("from=%v", from))
+	}
+	if to := ctx.Int64(auditToFlag.Name); to != 0 {
+		params = append(params, fmt.Sprintf("to=%v", to))
+	}
+	params = append(params, fmt.Sprintf("limit=%v", ctx.Int(auditLimitFlag.Name)))
+
+	log.Printf("admin audit: %v", params)
+
+	result, err := adminCall(method, params)
+	if err != nil {
+		return err
+	}
+	if !printIndentedJSON(result) {
+		log.Printf("result is '%v'", result)
+	}
+	return nil
+}
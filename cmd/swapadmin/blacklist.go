@@ -2,6 +2,9 @@ package main
 
 import (
 	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
 
 	"github.com/anyswap/CrossChain-Bridge/cmd/utils"
 	"github.com/anyswap/CrossChain-Bridge/log"
@@ -9,47 +12,181 @@ import (
 )
 
 var (
+	blacklistPairIDFlag = &cli.StringFlag{
+		Name:  "pairid",
+		Usage: "pairID of import entries without pairID ('all' means all pairs), or pairID to export and list",
+	}
+	blacklistReasonFlag = &cli.StringFlag{
+		Name:  "reason",
+		Usage: "reason of add and import entries without reason",
+	}
+	blacklistSourceFlag = &cli.StringFlag{
+		Name:  "source",
+		Usage: "source of add and import entries without source (default is the admin address)",
+	}
+	blacklistExpireFlag = &cli.StringFlag{
+		Name:  "expire",
+		Usage: "expire time of add and import entries without expire time (unix seconds or RFC3339 time)",
+	}
+	blacklistFormatFlag = &cli.StringFlag{
+		Name:  "format",
+		Usage: "csv or json format of import and export file (default is by file extension)",
+	}
+	blacklistOffsetFlag = &cli.IntFlag{
+		Name:  "offset",
+		Usage: "offset of list",
+	}
+	blacklistLimitFlag = &cli.IntFlag{
+		Name:  "limit",
+		Usage: "max number of entries to list",
+		Value: 100,
+	}
+
 	blacklistCommand = &cli.Command{
 		Action:    blacklist,
 		Name:      "blacklist",
 		Usage:     "admin blacklist",
-		ArgsUsage: "<add|remove|query> <address> <pairID>",
+		ArgsUsage: "<add|remove|query> <address> <pairID> | import <file> | export [file] | list",
 		Description: `
-admin blacklist
+admin blacklist, pairID 'all' means the entry blocks all pairs.
+import adds or overwrites entries from csv or json file,
+the first line of csv file is the header of columns (address,pairid,reason,source,expire),
+json file is an array of objects with the same fields.
+import file is limited to 4MB and 100000 entries, split larger files.
+export writes the not expired entries to file or stdout.
+list shows the not expired entries with pagination.
 `,
-		Flags: commonAdminFlags,
+		Flags: append([]cli.Flag{
+			blacklistPairIDFlag,
+			blacklistReasonFlag,
+			blacklistSourceFlag,
+			blacklistExpireFlag,
+			blacklistFormatFlag,
+			blacklistOffsetFlag,
+			blacklistLimitFlag,
+		}, commonAdminFlags...),
 	}
 )
 
 func blacklist(ctx *cli.Context) error {
 	utils.SetLogger(ctx)
 	method := "blacklist"
-	if ctx.NArg() != 3 {
+	operation := ctx.Args().Get(0)
+	switch {
+	case (operation == "add" || operation == "remove" || operation == "query") && ctx.NArg() == 3:
+	case operation == "import" && ctx.NArg() == 2:
+	case (operation == "export" && ctx.NArg() <= 2) || (operation == "list" && ctx.NArg() == 1):
+	default:
 		_ = cli.ShowCommandHelp(ctx, method)
 		fmt.Println()
 		return fmt.Errorf("invalid arguments: %q", ctx.Args())
 	}
 
-	err := prepare(ctx)
+	params, err := getBlacklistParams(ctx)
 	if err != nil {
 		return err
 	}
 
-	operation := ctx.Args().Get(0)
-	address := ctx.Args().Get(1)
-	pairID := ctx.Args().Get(2)
-
-	switch operation {
-	case "add", "remove", "query":
-	default:
-		return fmt.Errorf("unknown operation '%v'", operation)
+	err = prepare(ctx)
+	if err != nil {
+		return err
 	}
 
-	log.Printf("admin blacklist: %v %v %v", operation, address, pairID)
+	if operation == "import" {
+		log.Printf("admin blacklist: import %v %v", ctx.Args().Get(1), params[3:])
+	} else {
+		log.Printf("admin blacklist: %v", params)
+	}
 
-	params := []string{operation, address, pairID}
 	result, err := adminCall(method, params)
+	if err != nil {
+		return err
+	}
 
+	if operation == "export" && ctx.NArg() == 2 {
+		outputFile := ctx.Args().Get(1)
+		err = ioutil.WriteFile(outputFile, []byte(fmt.Sprint(result)), 0644)
+		if err != nil {
+			return err
+		}
+		log.Printf("export blacklist to '%v' success", outputFile)
+		return nil
+	}
+	if operation == "export" && getBlacklistFileFormat(ctx, "") == "csv" {
+		fmt.Print(result)
+		return nil
+	}
+	if (operation == "export" || operation == "list") && printIndentedJSON(result) {
+		return nil
+	}
 	log.Printf("result is '%v'", result)
-	return err
+	return nil
+}
+
+func getBlacklistParams(ctx *cli.Context) (params []string, err error) {
+	operation := ctx.Args().Get(0)
+	var options []string
+	addOption := func(key, value string) {
+		if value != "" {
+			options = append(options, key+"="+value)
+		}
+	}
+	addEntryOptions := func() error {
+		addOption("reason", ctx.String(blacklistReasonFlag.Name))
+		addOption("source", ctx.String(blacklistSourceFlag.Name))
+		if expire := ctx.String(blacklistExpireFlag.Name); expire != "" {
+			timestamp, err := parseTimeArg(expire)
+			if err != nil {
+				return err
+			}
+			addOption("expire", fmt.Sprint(timestamp))
+		}
+		return nil
+	}
+
+	switch operation {
+	case "add":
+		params = ctx.Args().Slice()
+		err = addEntryOptions()
+	case "remove", "query":
+		params = ctx.Args().Slice()
+	case "import":
+		inputFile := ctx.Args().Get(1)
+		format := getBlacklistFileFormat(ctx, inputFile)
+		if format != "csv" && format != "json" {
+			return nil, fmt.Errorf("unknown format of file '%v', please specify csv or json format", inputFile)
+		}
+		var data []byte
+		data, err = ioutil.ReadFile(inputFile)
+		if err != nil {
+			return nil, err
+		}
+		params = []string{operation, format, string(data)}
+		addOption("pairid", ctx.String(blacklistPairIDFlag.Name))
+		err = addEntryOptions()
+	case "export":
+		params = []string{operation}
+		addOption("pairid", ctx.String(blacklistPairIDFlag.Name))
+		format := getBlacklistFileFormat(ctx, ctx.Args().Get(1))
+		if format == "csv" {
+			addOption("format", format)
+		}
+	case "list":
+		params = []string{operation}
+		addOption("pairid", ctx.String(blacklistPairIDFlag.Name))
+		addOption("offset", fmt.Sprint(ctx.Int(blacklistOffsetFlag.Name)))
+		addOption("limit", fmt.Sprint(ctx.Int(blacklistLimitFlag.Name)))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return append(params, options...), nil
+}
+
+// get file format from format flag or file extension
+func getBlacklistFileFormat(ctx *cli.Context, fileName string) string {
+	if format := ctx.String(blacklistFormatFlag.Name); format != "" {
+		return strings.ToLower(format)
+	}
+	return strings.TrimPrefix(strings.ToLower(filepath.Ext(fileName)), ".")
 }
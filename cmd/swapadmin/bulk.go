@@ -0,0 +1,132 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/anyswap/CrossChain-Bridge/cmd/utils"
+	"github.com/anyswap/CrossChain-Bridge/log"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	bulkPairIDFlag = &cli.StringFlag{
+		Name:  "pairid",
+		Usage: "filter by pairID",
+	}
+	bulkBindFlag = &cli.StringFlag{
+		Name:  "bind",
+		Usage: "filter by bind address",
+	}
+	bulkStatusFlag = &cli.StringFlag{
+		Name:  "status",
+		Usage: "filter by swap status (comma separated status numbers)",
+	}
+	bulkFromFlag = &cli.Int64Flag{
+		Name:  "from",
+		Usage: "filter by swap register time from (unix seconds, inclusive)",
+	}
+	bulkToFlag = &cli.Int64Flag{
+		Name:  "to",
+		Usage: "filter by swap register time to (unix seconds, exclusive)",
+	}
+	bulkLimitFlag = &cli.IntFlag{
+		Name:  "limit",
+		Usage: "max number of swaps to process",
+		Value: 1000,
+	}
+	bulkMemoFlag = &cli.StringFlag{
+		Name:  "memo",
+		Usage: "memo of manual method",
+	}
+	bulkForceFlag = &cli.BoolFlag{
+		Name:  "force",
+		Usage: "force reswap of reswap method",
+	}
+
+	bulkCommand = &cli.Command{
+		Action:    bulk,
+		Name:      "bulk",
+		Usage:     "bulk reverify, reswap, manual or bigvalue swaps by filter",
+		ArgsUsage: "<dryrun|execute> <reverify|reswap|manual|bigvalue> <operation> | status <jobID>",
+		Description: `
+bulk process swaps matched by filter flags with admin method and operation,
+the operations are the same as that of the corresponding admin command.
+dryrun returns count and samples of the matched swaps,
+execute starts a job on server processing the matched swaps,
+status returns progress of the job.
+the permission and approvals of the admin method are required.
+`,
+		Flags: append([]cli.Flag{
+			bulkPairIDFlag,
+			bulkBindFlag,
+			bulkStatusFlag,
+			bulkFromFlag,
+			bulkToFlag,
+			bulkLimitFlag,
+			bulkMemoFlag,
+			bulkForceFlag,
+		}, commonAdminFlags...),
+	}
+)
+
+func bulk(ctx *cli.Context) error {
+	utils.SetLogger(ctx)
+	method := "bulk"
+	operation := ctx.Args().Get(0)
+	switch {
+	case operation == "status" && ctx.NArg() == 2:
+	case (operation == "dryrun" || operation == "execute") && ctx.NArg() == 3:
+	default:
+		_ = cli.ShowCommandHelp(ctx, method)
+		fmt.Println()
+		return fmt.Errorf("invalid arguments: %q", ctx.Args())
+	}
+
+	err := prepare(ctx)
+	if err != nil {
+		return err
+	}
+
+	params := ctx.Args().Slice()
+	if operation != "status" {
+		params = append(params, getBulkFilterParams(ctx)...)
+	}
+
+	log.Printf("admin bulk: %v", params)
+
+	result, err := adminCall(method, params)
+	if err != nil {
+		return err
+	}
+	if operation != "execute" && printIndentedJSON(result) {
+		return nil
+	}
+	log.Printf("result is '%v'", result)
+	return nil
+}
+
+func getBulkFilterParams(ctx *cli.Context) (params []string) {
+	if pairID := ctx.String(bulkPairIDFlag.Name); pairID != "" {
+		params = append(params, "pairid="+pairID)
+	}
+	if bind := ctx.String(bulkBindFlag.Name); bind != "" {
+		params = append(params, "bind="+bind)
+	}
+	if status := ctx.String(bulkStatusFlag.Name); status != "" {
+		params = append(params, "status="+status)
+	}
+	if from := ctx.Int64(bulkFromFlag.Name); from != 0 {
+		params = append(params, fmt.Sprintf("from=%v", from))
+	}
+	if to := ctx.Int64(bulkToFlag.Name); to != 0 {
+		params = append(params, fmt.Sprintf("to=%v", to))
+	}
+	if memo := ctx.String(bulkMemoFlag.Name); memo != "" {
+		params = append(params, "memo="+memo)
+	}
+	if ctx.Bool(bulkForceFlag.Name) {
+		params = append(params, "force=true")
+	}
+	params = append(params, fmt.Sprintf("limit=%v", ctx.Int(bulkLimitFlag.Name)))
+	return params
+}
@@ -0,0 +1,289 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anyswap/CrossChain-Bridge/cmd/utils"
+	"github.com/anyswap/CrossChain-Bridge/common"
+	"github.com/anyswap/CrossChain-Bridge/dcrm"
+	"github.com/anyswap/CrossChain-Bridge/log"
+	"github.com/anyswap/CrossChain-Bridge/tokens"
+	"github.com/anyswap/CrossChain-Bridge/tokens/btc"
+	"github.com/anyswap/CrossChain-Bridge/tokens/ltc"
+	"github.com/anyswap/CrossChain-Bridge/tools/crypto"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	dcrmRPCFlag = &cli.StringFlag{
+		Name:  "dcrm",
+		Usage: "dcrm node RPC address",
+	}
+	dcrmModeFlag = &cli.StringFlag{
+		Name:  "mode",
+		Usage: "dcrm mode, 0:managed 1:private",
+		Value: "0",
+	}
+	disagreeFlag = &cli.BoolFlag{
+		Name:  "disagree",
+		Usage: "disagree instead of agree",
+	}
+	networkFlag = &cli.StringFlag{
+		Name:  "net",
+		Usage: "network identifier, ie. mainnet, testnet",
+		Value: "mainnet",
+	}
+
+	dcrmKeyFlags = []cli.Flag{
+		dcrmRPCFlag,
+		utils.KeystoreFileFlag,
+		utils.PasswordFileFlag,
+	}
+
+	dcrmCommand = &cli.Command{
+		Name:  "dcrm",
+		Usage: "dcrm group and key generation management",
+		Description: `
+dcrm group and key generation management,
+these commands talk to the dcrm node directly.
+`,
+		Subcommands: []*cli.Command{
+			{
+				Action:    dcrmCreateGroup,
+				Name:      "creategroup",
+				Usage:     "create dcrm group",
+				ArgsUsage: "<threshold> <enode> <enode>...",
+				Description: `
+create dcrm group with threshold (eg. 2/3) and member enodes.
+`,
+				Flags: []cli.Flag{dcrmRPCFlag},
+			},
+			{
+				Action:    dcrmGroupInfo,
+				Name:      "groupinfo",
+				Usage:     "list dcrm group members",
+				ArgsUsage: "<groupID>",
+				Description: `
+list members count and enodes of dcrm group.
+`,
+				Flags: []cli.Flag{dcrmRPCFlag},
+			},
+			{
+				Action:    dcrmReqAddr,
+				Name:      "reqaddr",
+				Usage:     "request dcrm address (key generation)",
+				ArgsUsage: "<groupID> <threshold>",
+				Description: `
+request dcrm address (REQDCRMADDR) of group with threshold (eg. 2/3),
+wait for the key generation finished, then print the public key.
+`,
+				Flags: append(dcrmKeyFlags, dcrmModeFlag),
+			},
+			{
+				Action:    dcrmAcceptAddr,
+				Name:      "acceptaddr",
+				Usage:     "accept dcrm address request (key generation)",
+				ArgsUsage: "[keyID]",
+				Description: `
+accept dcrm address request as an oracle.
+list pending requests if keyID is not specified.
+`,
+				Flags: append(dcrmKeyFlags, disagreeFlag),
+			},
+			{
+				Action:    dcrmAddress,
+				Name:      "address",
+				Usage:     "derive addresses from dcrm public key",
+				ArgsUsage: "<pubkey>",
+				Description: `
+derive BTC, LTC and ETH addresses from dcrm public key.
+`,
+				Flags: []cli.Flag{networkFlag},
+			},
+		},
+	}
+)
+
+func getDcrmRPCAddress(ctx *cli.Context) (string, error) {
+	rpcAddr := ctx.String(dcrmRPCFlag.Name)
+	if rpcAddr == "" {
+		return "", fmt.Errorf("must specify dcrm node RPC address")
+	}
+	return rpcAddr, nil
+}
+
+func loadDcrmNodeInfo(ctx *cli.Context) (*dcrm.NodeInfo, error) {
+	rpcAddr, err := getDcrmRPCAddress(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keyfile := ctx.String(utils.KeystoreFileFlag.Name)
+	passfile := ctx.String(utils.PasswordFileFlag.Name)
+	if keyfile == "" {
+		return nil, fmt.Errorf("must specify keystore file")
+	}
+	return dcrm.NewNodeInfo(rpcAddr, keyfile, passfile)
+}
+
+func dcrmCreateGroup(ctx *cli.Context) error {
+	utils.SetLogger(ctx)
+	if ctx.NArg() < 2 {
+		_ = cli.ShowCommandHelp(ctx, "creategroup")
+		fmt.Println()
+		return fmt.Errorf("invalid arguments: %q", ctx.Args())
+	}
+	rpcAddr, err := getDcrmRPCAddress(ctx)
+	if err != nil {
+		return err
+	}
+	threshold := ctx.Args().Get(0)
+	enodes := ctx.Args().Slice()[1:]
+
+	log.Printf("dcrm creategroup: %v %v", threshold, enodes)
+
+	groupInfo, err := dcrm.CreateGroup(threshold, enodes, rpcAddr)
+	if err != nil {
+		return err
+	}
+	log.Printf("create group success, groupID is %v", groupInfo.GID)
+	return nil
+}
+
+func dcrmGroupInfo(ctx *cli.Context) error {
+	utils.SetLogger(ctx)
+	if ctx.NArg() != 1 {
+		_ = cli.ShowCommandHelp(ctx, "groupinfo")
+		fmt.Println()
+		return fmt.Errorf("invalid arguments: %q", ctx.Args())
+	}
+	rpcAddr, err := getDcrmRPCAddress(ctx)
+	if err != nil {
+		return err
+	}
+	groupID := ctx.Args().Get(0)
+
+	groupInfo, err := dcrm.GetGroupByID(groupID, rpcAddr)
+	if err != nil {
+		return err
+	}
+	log.Printf("groupID is %v, members count is %v", groupInfo.GID, groupInfo.Count)
+	for i, enode := range groupInfo.Enodes {
+		log.Printf("enode %v: %v", i, enode)
+	}
+	return nil
+}
+
+func dcrmReqAddr(ctx *cli.Context) error {
+	utils.SetLogger(ctx)
+	if ctx.NArg() != 2 {
+		_ = cli.ShowCommandHelp(ctx, "reqaddr")
+		fmt.Println()
+		return fmt.Errorf("invalid arguments: %q", ctx.Args())
+	}
+	nodeInfo, err := loadDcrmNodeInfo(ctx)
+	if err != nil {
+		return err
+	}
+	groupID := ctx.Args().Get(0)
+	threshold := ctx.Args().Get(1)
+	mode := ctx.String(dcrmModeFlag.Name)
+
+	log.Printf("dcrm reqaddr: %v %v %v", groupID, threshold, mode)
+
+	keyID, err := dcrm.DoReqDcrmAddr(nodeInfo, groupID, threshold, mode)
+	if err != nil {
+		return err
+	}
+	log.Printf("request dcrm address success, keyID is %v", keyID)
+
+	pubkey, err := dcrm.GetReqAddrResult(keyID, nodeInfo.GetDcrmRPCAddress())
+	if err != nil {
+		return err
+	}
+	log.Printf("key generation success, pubkey is %v", pubkey)
+	return nil
+}
+
+func dcrmAcceptAddr(ctx *cli.Context) error {
+	utils.SetLogger(ctx)
+	if ctx.NArg() > 1 {
+		_ = cli.ShowCommandHelp(ctx, "acceptaddr")
+		fmt.Println()
+		return fmt.Errorf("invalid arguments: %q", ctx.Args())
+	}
+	nodeInfo, err := loadDcrmNodeInfo(ctx)
+	if err != nil {
+		return err
+	}
+	rpcAddr := nodeInfo.GetDcrmRPCAddress()
+
+	if ctx.NArg() == 0 {
+		infos, errf := dcrm.GetCurNodeReqAddrInfo(nodeInfo.GetDcrmUser().String(), rpcAddr)
+		if errf != nil {
+			return errf
+		}
+		log.Printf("pending dcrm address requests count is %v", len(infos))
+		for _, info := range infos {
+			log.Printf("keyID %v, account %v, groupID %v, threshold %v, mode %v", info.Key, info.Account, info.GroupID, info.ThresHold, info.Mode)
+		}
+		return nil
+	}
+
+	keyID := ctx.Args().Get(0)
+	agreeResult := "AGREE"
+	if ctx.Bool(disagreeFlag.Name) {
+		agreeResult = "DISAGREE"
+	}
+
+	log.Printf("dcrm acceptaddr: %v %v", keyID, agreeResult)
+
+	result, err := dcrm.DoAcceptReqAddr(nodeInfo, keyID, agreeResult)
+
+	log.Printf("result is '%v'", result)
+	return err
+}
+
+func dcrmAddress(ctx *cli.Context) error {
+	utils.SetLogger(ctx)
+	if ctx.NArg() != 1 {
+		_ = cli.ShowCommandHelp(ctx, "address")
+		fmt.Println()
+		return fmt.Errorf("invalid arguments: %q", ctx.Args())
+	}
+	pkData := common.FromHex(ctx.Args().Get(0))
+	isMainnet := strings.EqualFold(ctx.String(networkFlag.Name), "mainnet")
+
+	pubKey, err := crypto.UnmarshalPubkey(pkData)
+	if err != nil {
+		return fmt.Errorf("wrong public key, %v", err)
+	}
+	log.Printf("ETH address is %v", crypto.PubkeyToAddress(*pubKey).String())
+
+	btcBridge := btc.NewCrossChainBridge(true)
+	btcBridge.ChainConfig = &tokens.ChainConfig{BlockChain: "Bitcoin", NetID: "testnet3"}
+	if isMainnet {
+		btcBridge.ChainConfig.NetID = "mainnet"
+	}
+	cPkData, err := btcBridge.ToCompressedPublicKey(pkData)
+	if err != nil {
+		return err
+	}
+	btcAddress, err := btcBridge.NewAddressPubKeyHash(cPkData)
+	if err != nil {
+		return err
+	}
+	log.Printf("BTC address is %v", btcAddress.EncodeAddress())
+
+	ltcBridge := ltc.NewCrossChainBridge(true)
+	ltcBridge.ChainConfig = &tokens.ChainConfig{BlockChain: "Litecoin", NetID: "testnet4"}
+	if isMainnet {
+		ltcBridge.ChainConfig.NetID = "mainnet"
+	}
+	ltcAddress, err := ltcBridge.NewAddressPubKeyHash(cPkData)
+	if err != nil {
+		return err
+	}
+	log.Printf("LTC address is %v", ltcAddress.EncodeAddress())
+	return nil
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/anyswap/CrossChain-Bridge/cmd/utils"
+	"github.com/anyswap/CrossChain-Bridge/log"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	dcrmhealthCommand = &cli.Command{
+		Action:    dcrmhealth,
+		Name:      "dcrmhealth",
+		Usage:     "query dcrm nodes and sign groups health",
+		ArgsUsage: " ",
+		Description: `
+query health statistics of dcrm initiator nodes and sign groups,
+including success rate, latency, consecutive failures,
+and whether the circuit is broken.
+`,
+		Flags: commonAdminFlags,
+	}
+)
+
+func dcrmhealth(ctx *cli.Context) error {
+	utils.SetLogger(ctx)
+	method := "dcrmhealth"
+	if ctx.NArg() != 0 {
+		_ = cli.ShowCommandHelp(ctx, method)
+		fmt.Println()
+		return fmt.Errorf("invalid arguments: %q", ctx.Args())
+	}
+
+	err := prepare(ctx)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("admin dcrmhealth")
+
+	result, err := adminCall(method, []string{})
+
+	log.Printf("result is '%v'", result)
+	return err
+}
@@ -0,0 +1,512 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/anyswap/CrossChain-Bridge/cmd/utils"
+	"github.com/anyswap/CrossChain-Bridge/mongodb"
+	"github.com/anyswap/CrossChain-Bridge/rpc/apitypes"
+	"github.com/anyswap/CrossChain-Bridge/rpc/swapclient"
+	"github.com/anyswap/CrossChain-Bridge/tokens"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	tableOutput = "table"
+	jsonOutput  = "json"
+
+	swapinType  = "swapin"
+	swapoutType = "swapout"
+)
+
+var (
+	outputFlag = &cli.StringFlag{
+		Name:  "output",
+		Usage: "output format, table or json",
+		Value: tableOutput,
+	}
+	offsetFlag = &cli.IntFlag{
+		Name:  "offset",
+		Usage: "offset of history",
+	}
+	limitFlag = &cli.IntFlag{
+		Name:  "limit",
+		Usage: "max number of swaps",
+		Value: 20,
+	}
+	pendingLimitFlag = &cli.IntFlag{
+		Name:  "limit",
+		Usage: "max number of pending swaps to query",
+		Value: 1000,
+	}
+	swapTypeFlag = &cli.StringFlag{
+		Name:  "swaptype",
+		Usage: "swap type, swapin or swapout, empty means both",
+	}
+
+	queryFlags = []cli.Flag{
+		utils.SwapServerFlag,
+		outputFlag,
+	}
+
+	// swap results which are waiting for swap tx or its stable
+	pendingStatuses = []apitypes.SwapStatus{
+		apitypes.SwapStatus(mongodb.MatchTxEmpty),
+		apitypes.SwapStatus(mongodb.MatchTxNotStable),
+		apitypes.SwapStatus(mongodb.TxWithBigValue),
+	}
+
+	pendingAgeBuckets = []time.Duration{
+		10 * time.Minute,
+		time.Hour,
+		6 * time.Hour,
+		24 * time.Hour,
+	}
+
+	getCommand = &cli.Command{
+		Name:  "get",
+		Usage: "query and inspect swap server",
+		Description: `
+read only query and inspection commands through the swap server public api,
+no keystore is required.
+`,
+		Subcommands: []*cli.Command{
+			{
+				Action:    getSwap,
+				Name:      "swap",
+				Usage:     "get swap",
+				ArgsUsage: "<swapin|swapout> <txid> <pairID> [bind]",
+				Flags:     queryFlags,
+			},
+			{
+				Action:    getHistory,
+				Name:      "history",
+				Usage:     "get swap history of address",
+				ArgsUsage: "<swapin|swapout> <address> [pairID]",
+				Description: `
+get swap history of address, pairID is 'all' if not specified.
+`,
+				Flags: append([]cli.Flag{offsetFlag, limitFlag}, queryFlags...),
+			},
+			{
+				Action:    getStats,
+				Name:      "stats",
+				Usage:     "get swap statistics of pair",
+				ArgsUsage: "<pairID>",
+				Flags:     queryFlags,
+			},
+			{
+				Action:    getPending,
+				Name:      "pending",
+				Usage:     "get pending swaps grouped by status and age",
+				ArgsUsage: "[pairID]",
+				Description: `
+count swaps waiting for swap tx or its stable, grouped by swap type, status and age,
+pairID is 'all' if not specified.
+`,
+				Flags: append([]cli.Flag{swapTypeFlag, pendingLimitFlag}, queryFlags...),
+			},
+			{
+				Action:    getNonces,
+				Name:      "nonces",
+				Usage:     "get swap nonces in database and on chain",
+				ArgsUsage: "[pairID]",
+				Description: `
+compare the latest swap nonce in database with the latest and pending nonce on chain
+of the swap signers, pairID is 'all' if not specified.
+`,
+				Flags: queryFlags,
+			},
+			{
+				Action: getScanInfo,
+				Name:   "scaninfo",
+				Usage:  "get latest scanned block of source and dest chain",
+				Flags:  queryFlags,
+			},
+			{
+				Action:    getPairs,
+				Name:      "pairs",
+				Usage:     "get token pairs and their swap states",
+				ArgsUsage: "[pairID]...",
+				Description: `
+get all token pairs if pairID is not specified.
+`,
+				Flags: queryFlags,
+			},
+		},
+	}
+)
+
+func newQueryClient(ctx *cli.Context, command string, minArgs, maxArgs int) (*swapclient.Client, error) {
+	utils.SetLogger(ctx)
+	if ctx.NArg() < minArgs || (maxArgs >= 0 && ctx.NArg() > maxArgs) {
+		_ = cli.ShowCommandHelp(ctx, command)
+		fmt.Println()
+		return nil, fmt.Errorf("invalid arguments: %q", ctx.Args())
+	}
+	switch ctx.String(outputFlag.Name) {
+	case tableOutput, jsonOutput:
+	default:
+		return nil, fmt.Errorf("unknown output format '%v'", ctx.String(outputFlag.Name))
+	}
+	err := initSwapServer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return swapclient.NewClient(swapServer), nil
+}
+
+func isSwapinType(swapType string) (bool, error) {
+	switch swapType {
+	case swapinType:
+		return true, nil
+	case swapoutType:
+		return false, nil
+	default:
+		return false, fmt.Errorf("unknown swap type '%v'", swapType)
+	}
+}
+
+// print result as json if specified, otherwise print table by printTable
+func printResult(ctx *cli.Context, result interface{}, printTable func(w *tabwriter.Writer)) error {
+	if ctx.String(outputFlag.Name) == jsonOutput {
+		data, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	printTable(w)
+	return w.Flush()
+}
+
+func printRow(w *tabwriter.Writer, columns ...interface{}) {
+	strs := make([]string, len(columns))
+	for i, column := range columns {
+		strs[i] = fmt.Sprint(column)
+	}
+	fmt.Fprintln(w, strings.Join(strs, "\t"))
+}
+
+func formatTime(timestamp int64) string {
+	if timestamp == 0 {
+		return "-"
+	}
+	return time.Unix(timestamp, 0).Format("2006-01-02 15:04:05")
+}
+
+func getSwap(ctx *cli.Context) error {
+	client, err := newQueryClient(ctx, "swap", 3, 4)
+	if err != nil {
+		return err
+	}
+	isSwapin, err := isSwapinType(ctx.Args().Get(0))
+	if err != nil {
+		return err
+	}
+	txid := ctx.Args().Get(1)
+	pairID := ctx.Args().Get(2)
+	bind := ctx.Args().Get(3)
+	var swap *apitypes.SwapInfo
+	if isSwapin {
+		swap, err = client.GetSwapin(txid, pairID, bind)
+	} else {
+		swap, err = client.GetSwapout(txid, pairID, bind)
+	}
+	if err != nil {
+		return err
+	}
+	return printResult(ctx, swap, func(w *tabwriter.Writer) {
+		printRow(w, "pairid", swap.PairID)
+		printRow(w, "txid", swap.TxID)
+		printRow(w, "txto", swap.TxTo)
+		printRow(w, "txheight", swap.TxHeight)
+		printRow(w, "txtime", formatTime(int64(swap.TxTime)))
+		printRow(w, "from", swap.From)
+		printRow(w, "to", swap.To)
+		printRow(w, "bind", swap.Bind)
+		printRow(w, "value", swap.Value)
+		printRow(w, "swaptx", swap.SwapTx)
+		printRow(w, "swapheight", swap.SwapHeight)
+		printRow(w, "swaptime", formatTime(int64(swap.SwapTime)))
+		printRow(w, "swapvalue", swap.SwapValue)
+		printRow(w, "swaptype", tokens.SwapType(swap.SwapType).String())
+		printRow(w, "swapnonce", swap.SwapNonce)
+		printRow(w, "status", fmt.Sprintf("%d (%v)", swap.Status, swap.StatusMsg))
+		printRow(w, "inittime", formatTime(swap.InitTime/1000))
+		printRow(w, "timestamp", formatTime(swap.Timestamp))
+		printRow(w, "memo", swap.Memo)
+		printRow(w, "confirmations", swap.Confirmations)
+	})
+}
+
+func getHistory(ctx *cli.Context) error {
+	client, err := newQueryClient(ctx, "history", 2, 3)
+	if err != nil {
+		return err
+	}
+	isSwapin, err := isSwapinType(ctx.Args().Get(0))
+	if err != nil {
+		return err
+	}
+	address := ctx.Args().Get(1)
+	pairID := ctx.Args().Get(2)
+	if pairID == "" {
+		pairID = "all"
+	}
+	offset := ctx.Int(offsetFlag.Name)
+	limit := ctx.Int(limitFlag.Name)
+	var swaps []*apitypes.SwapInfo
+	if isSwapin {
+		swaps, err = client.GetSwapinHistory(address, pairID, offset, limit)
+	} else {
+		swaps, err = client.GetSwapoutHistory(address, pairID, offset, limit)
+	}
+	if err != nil {
+		return err
+	}
+	return printResult(ctx, swaps, func(w *tabwriter.Writer) {
+		printRow(w, "PAIRID", "TXID", "BIND", "VALUE", "SWAPVALUE", "STATUS", "SWAPTX", "INITTIME")
+		for _, swap := range swaps {
+			printRow(w, swap.PairID, swap.TxID, swap.Bind, swap.Value, swap.SwapValue, swap.StatusMsg, swap.SwapTx, formatTime(swap.InitTime/1000))
+		}
+	})
+}
+
+func getStats(ctx *cli.Context) error {
+	client, err := newQueryClient(ctx, "stats", 1, 1)
+	if err != nil {
+		return err
+	}
+	stats, err := client.GetSwapStatistics(ctx.Args().Get(0))
+	if err != nil {
+		return err
+	}
+	return printResult(ctx, stats, func(w *tabwriter.Writer) {
+		printRow(w, "SWAPTYPE", "TOTAL", "PENDING", "STABLE", "STABLEVALUE", "STABLEFEE")
+		printRow(w, swapinType, stats.TotalSwapinCount, stats.PendingSwapinCount, stats.StableSwapinCount, stats.TotalSwapinValue, stats.TotalSwapinFee)
+		printRow(w, swapoutType, stats.TotalSwapoutCount, stats.PendingSwapoutCount, stats.StableSwapoutCount, stats.TotalSwapoutValue, stats.TotalSwapoutFee)
+	})
+}
+
+// PendingSwapGroup pending swaps of the same swap type and status
+type PendingSwapGroup struct {
+	SwapType   string `json:"swaptype"`
+	Status     string `json:"status"`
+	Total      int    `json:"total"`
+	AgeCounts  []int  `json:"agecounts"` // count of each age bucket
+	OldestTime int64  `json:"oldesttime"`
+}
+
+func getPending(ctx *cli.Context) error {
+	client, err := newQueryClient(ctx, "pending", 0, 1)
+	if err != nil {
+		return err
+	}
+	pairID := ctx.Args().Get(0)
+	swapTypes := []string{swapinType, swapoutType}
+	if swapType := ctx.String(swapTypeFlag.Name); swapType != "" {
+		if _, err = isSwapinType(swapType); err != nil {
+			return err
+		}
+		swapTypes = []string{swapType}
+	}
+	limit := ctx.Int(pendingLimitFlag.Name)
+
+	var groups []*PendingSwapGroup
+	now := time.Now()
+	for _, swapType := range swapTypes {
+		swaps, errq := queryPendingSwaps(client, swapType, pairID, limit)
+		if errq != nil {
+			return errq
+		}
+		groups = append(groups, groupPendingSwaps(swapType, swaps, now)...)
+	}
+
+	return printResult(ctx, groups, func(w *tabwriter.Writer) {
+		header := []interface{}{"SWAPTYPE", "STATUS", "TOTAL"}
+		for _, age := range pendingAgeBuckets {
+			header = append(header, "<"+age.String())
+		}
+		header = append(header, ">="+pendingAgeBuckets[len(pendingAgeBuckets)-1].String(), "OLDEST")
+		printRow(w, header...)
+		for _, group := range groups {
+			row := []interface{}{group.SwapType, group.Status, group.Total}
+			for _, count := range group.AgeCounts {
+				row = append(row, count)
+			}
+			row = append(row, formatTime(group.OldestTime/1000))
+			printRow(w, row...)
+		}
+	})
+}
+
+func queryPendingSwaps(client *swapclient.Client, swapType, pairID string, limit int) ([]*apitypes.SwapInfo, error) {
+	args := &apitypes.QuerySwapsArgs{
+		SwapType: swapType,
+		PairID:   pairID,
+		Status:   pendingStatuses,
+		Order:    "asc",
+	}
+	var swaps []*apitypes.SwapInfo
+	for len(swaps) < limit {
+		args.Limit = limit - len(swaps)
+		res, err := client.QuerySwaps(args)
+		if err != nil {
+			return nil, err
+		}
+		swaps = append(swaps, res.Swaps...)
+		if res.NextCursor == "" || len(res.Swaps) == 0 {
+			break
+		}
+		args.Cursor = res.NextCursor
+	}
+	return swaps, nil
+}
+
+func groupPendingSwaps(swapType string, swaps []*apitypes.SwapInfo, now time.Time) []*PendingSwapGroup {
+	groupsMap := make(map[apitypes.SwapStatus]*PendingSwapGroup)
+	var groups []*PendingSwapGroup
+	for _, swap := range swaps {
+		group, exist := groupsMap[swap.Status]
+		if !exist {
+			group = &PendingSwapGroup{
+				SwapType:  swapType,
+				Status:    swap.StatusMsg,
+				AgeCounts: make([]int, len(pendingAgeBuckets)+1),
+			}
+			groupsMap[swap.Status] = group
+			groups = append(groups, group)
+		}
+		group.Total++
+		if group.OldestTime == 0 || swap.InitTime < group.OldestTime {
+			group.OldestTime = swap.InitTime
+		}
+		age := now.Sub(time.Unix(0, swap.InitTime*int64(time.Millisecond)))
+		bucket := len(pendingAgeBuckets)
+		for i, maxAge := range pendingAgeBuckets {
+			if age < maxAge {
+				bucket = i
+				break
+			}
+		}
+		group.AgeCounts[bucket]++
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Status < groups[j].Status
+	})
+	return groups
+}
+
+func getNonces(ctx *cli.Context) error {
+	client, err := newQueryClient(ctx, "nonces", 0, 1)
+	if err != nil {
+		return err
+	}
+	pairID := ctx.Args().Get(0)
+	if pairID == "" {
+		pairID = "all"
+	}
+	nonces, err := client.GetSwapNonces(pairID)
+	if err != nil {
+		return err
+	}
+	return printResult(ctx, nonces, func(w *tabwriter.Writer) {
+		printRow(w, "SWAPTYPE", "ADDRESS", "DBNONCE", "LATESTNONCE", "PENDINGNONCE", "PAIRIDS", "ERROR")
+		for _, info := range nonces {
+			swapType := swapoutType
+			if info.IsSwapin {
+				swapType = swapinType
+			}
+			printRow(w, swapType, info.Address, info.DBNonce, info.LatestNonce, info.PendingNonce, strings.Join(info.PairIDs, ","), info.Error)
+		}
+	})
+}
+
+func getScanInfo(ctx *cli.Context) error {
+	client, err := newQueryClient(ctx, "scaninfo", 0, 0)
+	if err != nil {
+		return err
+	}
+	srcInfo, err := client.GetLatestScanInfo(true)
+	if err != nil {
+		return err
+	}
+	dstInfo, err := client.GetLatestScanInfo(false)
+	if err != nil {
+		return err
+	}
+	result := map[string]*apitypes.LatestScanInfo{
+		"src":  srcInfo,
+		"dest": dstInfo,
+	}
+	return printResult(ctx, result, func(w *tabwriter.Writer) {
+		printRow(w, "CHAIN", "BLOCKHEIGHT", "TIMESTAMP")
+		printRow(w, "src", srcInfo.BlockHeight, formatTime(srcInfo.Timestamp))
+		printRow(w, "dest", dstInfo.BlockHeight, formatTime(dstInfo.Timestamp))
+	})
+}
+
+func getPairs(ctx *cli.Context) error {
+	client, err := newQueryClient(ctx, "pairs", 0, -1)
+	if err != nil {
+		return err
+	}
+	pairIDs := ctx.Args().Slice()
+	if len(pairIDs) == 0 {
+		serverInfo, errq := client.GetServerInfo()
+		if errq != nil {
+			return errq
+		}
+		pairIDs = serverInfo.PairIDs
+		sort.Strings(pairIDs)
+	}
+	pairs := make([]*apitypes.TokenPairInfo, 0, len(pairIDs))
+	for _, pairID := range pairIDs {
+		pairCfg, errq := client.GetTokenPairInfo(pairID)
+		if errq != nil {
+			return fmt.Errorf("get token pair '%v' failed: %v", pairID, errq)
+		}
+		pairs = append(pairs, pairCfg)
+	}
+	return printResult(ctx, pairs, func(w *tabwriter.Writer) {
+		printRow(w, "PAIRID", "SRC", "DEST", "DEPOSIT", "WITHDRAW", "STATE")
+		for _, pairCfg := range pairs {
+			printRow(w, pairCfg.PairID,
+				pairCfg.SrcToken.Symbol, pairCfg.DestToken.Symbol,
+				getSwitchState(pairCfg.SrcToken), getSwitchState(pairCfg.DestToken),
+				getSwapStateDesc(pairCfg))
+		}
+	})
+}
+
+func getSwitchState(tokenCfg *apitypes.TokenInfo) string {
+	if tokenCfg.DisableSwap {
+		return "closed"
+	}
+	return "open"
+}
+
+func getSwapStateDesc(pairCfg *apitypes.TokenPairInfo) string {
+	var descs []string
+	addDesc := func(direction string, state *apitypes.SwapState) {
+		if state == nil {
+			return
+		}
+		descs = append(descs, fmt.Sprintf("%v set by %v at %v (%v)", direction, state.SetBy, formatTime(state.Timestamp), state.Reason))
+	}
+	addDesc("deposit", pairCfg.SrcToken.SwapState)
+	addDesc("withdraw", pairCfg.DestToken.SwapState)
+	if len(descs) == 0 {
+		return "-"
+	}
+	return strings.Join(descs, "; ")
+}
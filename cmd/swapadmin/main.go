@@ -31,8 +31,19 @@ func initApp() {
 		reswapCommand,
 		replaceswapCommand,
 		manualCommand,
+		payoutCommand,
 		setnonceCommand,
 		addpairCommand,
+		updatepairCommand,
+		removepairCommand,
+		dcrmhealthCommand,
+		dcrmCommand,
+		migrationCommand,
+		proposalsCommand,
+		auditCommand,
+		bulkCommand,
+		maintenanceCommand,
+		getCommand,
 		utils.LicenseCommand,
 		utils.VersionCommand,
 	}
@@ -13,10 +13,12 @@ var (
 		Action:    maintain,
 		Name:      "maintain",
 		Usage:     "maintain deposit and withdraw switch",
-		ArgsUsage: "<open|close> <deposit|withdraw|both> <pairID[,pairID]...>",
+		ArgsUsage: "<open|close> <deposit|withdraw|both> <pairID[,pairID]...> [reason]",
 		Description: `
 maintain service, open or close deposit and withdraw.
 pairIDs must be comma separated. pairIDs can be 'all'.
+the state is stored by server with the optional reason and the admin address,
+and survives server restarts.
 `,
 		Flags: commonAdminFlags,
 	}
@@ -25,7 +27,7 @@ pairIDs must be comma separated. pairIDs can be 'all'.
 func maintain(ctx *cli.Context) error {
 	utils.SetLogger(ctx)
 	method := "maintain"
-	if ctx.NArg() != 3 {
+	if ctx.NArg() < 3 || ctx.NArg() > 4 {
 		_ = cli.ShowCommandHelp(ctx, method)
 		fmt.Println()
 		return fmt.Errorf("invalid arguments: %q", ctx.Args())
@@ -52,9 +54,13 @@ func maintain(ctx *cli.Context) error {
 		return fmt.Errorf("unknown direction '%v'", direction)
 	}
 
-	log.Printf("admin maintain: %v %v %v", operation, direction, pairID)
-
 	params := []string{operation, direction, pairID}
+	if ctx.NArg() > 3 {
+		params = append(params, ctx.Args().Get(3))
+	}
+
+	log.Printf("admin maintain: %v", params)
+
 	result, err := adminCall(method, params)
 
 	log.Printf("result is '%v'", result)
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/anyswap/CrossChain-Bridge/cmd/utils"
+	"github.com/anyswap/CrossChain-Bridge/log"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	maintenanceCommand = &cli.Command{
+		Action:    maintenance,
+		Name:      "maintenance",
+		Usage:     "scheduled maintenance windows",
+		ArgsUsage: "<add> <deposit|withdraw|both> <pairID[,pairID]...> <start> <end> [reason] | <remove> <windowID> | <list>",
+		Description: `
+add, remove or list scheduled maintenance windows of token pairs.
+pairIDs must be comma separated. pairIDs can be 'all'.
+start and end time are unix seconds or RFC3339 time (eg. 2020-10-01T08:00:00Z).
+swap is paused during the window, and swap registers are queued
+and registered after the window ended.
+`,
+		Flags: commonAdminFlags,
+	}
+)
+
+func maintenance(ctx *cli.Context) error {
+	utils.SetLogger(ctx)
+	method := "maintenance"
+	operation := ctx.Args().Get(0)
+	switch {
+	case operation == "add" && (ctx.NArg() == 5 || ctx.NArg() == 6):
+	case operation == "remove" && ctx.NArg() == 2:
+	case operation == "list" && ctx.NArg() == 1:
+	default:
+		_ = cli.ShowCommandHelp(ctx, method)
+		fmt.Println()
+		return fmt.Errorf("invalid arguments: %q", ctx.Args())
+	}
+
+	params := ctx.Args().Slice()
+	if operation == "add" {
+		switch params[1] {
+		case "deposit", "withdraw", "both":
+		default:
+			return fmt.Errorf("unknown direction '%v'", params[1])
+		}
+		for i := 3; i <= 4; i++ {
+			timestamp, err := parseTimeArg(params[i])
+			if err != nil {
+				return err
+			}
+			params[i] = fmt.Sprintf("%v", timestamp)
+		}
+	}
+
+	err := prepare(ctx)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("admin maintenance: %v", params)
+
+	result, err := adminCall(method, params)
+	if err != nil {
+		return err
+	}
+	if operation == "list" && printIndentedJSON(result) {
+		return nil
+	}
+	log.Printf("result is '%v'", result)
+	return nil
+}
+
+// parse unix seconds or RFC3339 time
+func parseTimeArg(arg string) (int64, error) {
+	if timestamp, err := strconv.ParseInt(arg, 10, 64); err == nil {
+		return timestamp, nil
+	}
+	t, err := time.Parse(time.RFC3339, arg)
+	if err != nil {
+		return 0, fmt.Errorf("wrong time '%v', must be unix seconds or RFC3339 time", arg)
+	}
+	return t.Unix(), nil
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/anyswap/CrossChain-Bridge/cmd/utils"
+	"github.com/anyswap/CrossChain-Bridge/log"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	migrationCommand = &cli.Command{
+		Action:    migration,
+		Name:      "migration",
+		Usage:     "query dcrm address migration status",
+		ArgsUsage: " ",
+		Description: `
+query status of sweeping retiring dcrm addresses to active dcrm addresses,
+including last sweep tx, last error, and whether the sweep is finished.
+`,
+		Flags: commonAdminFlags,
+	}
+)
+
+func migration(ctx *cli.Context) error {
+	utils.SetLogger(ctx)
+	method := "migration"
+	if ctx.NArg() != 0 {
+		_ = cli.ShowCommandHelp(ctx, method)
+		fmt.Println()
+		return fmt.Errorf("invalid arguments: %q", ctx.Args())
+	}
+
+	err := prepare(ctx)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("admin migration")
+
+	result, err := adminCall(method, []string{})
+
+	log.Printf("result is '%v'", result)
+	return err
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/anyswap/CrossChain-Bridge/cmd/utils"
+	"github.com/anyswap/CrossChain-Bridge/log"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	payoutCommand = &cli.Command{
+		Action:    payout,
+		Name:      "payout",
+		Usage:     "manual payout of swap",
+		ArgsUsage: "<swapin|swapout> <txid> <pairID> <to> <value>",
+		Description: `
+manual payout of swap which can not be processed normally (eg. with wrong memo),
+txid is the original tx on source chain, to is the receiver on destination chain,
+value is the origin value in smallest unit, swap fee is deducted as normal swaps.
+the original tx must pay the bridge but be unswappable (wrong memo, wrong value
+or bind address is contract), and value must not exceed the value it paid.
+the payout is proposed and must be approved by at least 2 admins, and oracles
+verify it with this admin call and the approve calls of the other admins.
+`,
+		Flags: commonAdminFlags,
+	}
+)
+
+func payout(ctx *cli.Context) error {
+	utils.SetLogger(ctx)
+	method := "payout"
+	if ctx.NArg() != 5 {
+		_ = cli.ShowCommandHelp(ctx, method)
+		fmt.Println()
+		return fmt.Errorf("invalid arguments: %q", ctx.Args())
+	}
+
+	swapType := ctx.Args().Get(0)
+	switch swapType {
+	case "swapin", "swapout":
+	default:
+		return fmt.Errorf("unknown swap type '%v'", swapType)
+	}
+
+	err := prepare(ctx)
+	if err != nil {
+		return err
+	}
+
+	params := ctx.Args().Slice()
+
+	log.Printf("admin payout: %v", params)
+
+	result, err := adminCall(method, params)
+
+	log.Printf("result is '%v'", result)
+	return err
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/anyswap/CrossChain-Bridge/cmd/utils"
+	"github.com/anyswap/CrossChain-Bridge/log"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	proposalsCommand = &cli.Command{
+		Action:    proposals,
+		Name:      "proposals",
+		Usage:     "admin proposals",
+		ArgsUsage: "<list [pending|executing|executed|failed|cancelled|expired|all]> | <approve|cancel> <proposalID>",
+		Description: `
+list, approve or cancel admin proposals.
+admin methods configed in 'AdminApproval.Thresholds' of server config
+create proposals instead of calling directly, and are called
+after the proposals are approved by enough admins.
+list shows pending proposals by default.
+`,
+		Flags: commonAdminFlags,
+	}
+)
+
+func proposals(ctx *cli.Context) error {
+	utils.SetLogger(ctx)
+	method := "proposals"
+	if ctx.NArg() < 1 || ctx.NArg() > 2 {
+		_ = cli.ShowCommandHelp(ctx, method)
+		fmt.Println()
+		return fmt.Errorf("invalid arguments: %q", ctx.Args())
+	}
+
+	operation := ctx.Args().Get(0)
+	switch operation {
+	case "list":
+	case "approve", "cancel":
+		if ctx.NArg() != 2 {
+			return fmt.Errorf("must specify proposal ID to %v", operation)
+		}
+	default:
+		return fmt.Errorf("unknown operation '%v'", operation)
+	}
+
+	err := prepare(ctx)
+	if err != nil {
+		return err
+	}
+
+	params := ctx.Args().Slice()
+	log.Printf("admin proposals: %v", params)
+
+	result, err := adminCall(method, params)
+	if err != nil {
+		return err
+	}
+
+	if operation == "list" && printIndentedJSON(result) {
+		return nil
+	}
+	log.Printf("result is '%v'", result)
+	return nil
+}
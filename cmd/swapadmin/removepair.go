@@ -0,0 +1,47 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/anyswap/CrossChain-Bridge/cmd/utils"
+	"github.com/anyswap/CrossChain-Bridge/log"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	removepairCommand = &cli.Command{
+		Action:    removepair,
+		Name:      "removepair",
+		Usage:     "remove token pair",
+		ArgsUsage: "<pairID>",
+		Description: `
+remove token pair dynamically and stop its swap jobs
+`,
+		Flags: commonAdminFlags,
+	}
+)
+
+func removepair(ctx *cli.Context) error {
+	utils.SetLogger(ctx)
+	method := "removepair"
+	if ctx.NArg() != 1 {
+		_ = cli.ShowCommandHelp(ctx, method)
+		fmt.Println()
+		return fmt.Errorf("invalid arguments: %q", ctx.Args())
+	}
+
+	err := prepare(ctx)
+	if err != nil {
+		return err
+	}
+
+	pairID := ctx.Args().Get(0)
+
+	log.Printf("admin removepair: %v", pairID)
+
+	params := []string{pairID}
+	result, err := adminCall(method, params)
+
+	log.Printf("result is '%v'", result)
+	return err
+}
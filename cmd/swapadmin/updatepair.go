@@ -0,0 +1,48 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/anyswap/CrossChain-Bridge/cmd/utils"
+	"github.com/anyswap/CrossChain-Bridge/log"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	updatepairCommand = &cli.Command{
+		Action:    updatepair,
+		Name:      "updatepair",
+		Usage:     "update token pair",
+		ArgsUsage: "<configFile>",
+		Description: `
+update config of existing token pair dynamically through config file,
+the pairID, token addresses and decimals can not be changed
+`,
+		Flags: commonAdminFlags,
+	}
+)
+
+func updatepair(ctx *cli.Context) error {
+	utils.SetLogger(ctx)
+	method := "updatepair"
+	if ctx.NArg() != 1 {
+		_ = cli.ShowCommandHelp(ctx, method)
+		fmt.Println()
+		return fmt.Errorf("invalid arguments: %q", ctx.Args())
+	}
+
+	err := prepare(ctx)
+	if err != nil {
+		return err
+	}
+
+	configFile := ctx.Args().Get(0)
+
+	log.Printf("admin updatepair: %v", configFile)
+
+	params := []string{configFile}
+	result, err := adminCall(method, params)
+
+	log.Printf("result is '%v'", result)
+	return err
+}
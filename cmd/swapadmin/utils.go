@@ -1,11 +1,14 @@
 package main
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/anyswap/CrossChain-Bridge/admin"
 	"github.com/anyswap/CrossChain-Bridge/cmd/utils"
-	"github.com/anyswap/CrossChain-Bridge/rpc/client"
+	"github.com/anyswap/CrossChain-Bridge/rpc/swapclient"
 	"github.com/urfave/cli/v2"
 )
 
@@ -26,8 +29,7 @@ func adminCall(method string, params []string) (result interface{}, err error) {
 	}
 	timeout := 300
 	reqID := 1010
-	err = client.RPCPostWithTimeoutAndID(&result, timeout, reqID, swapServer, "swap.AdminCall", rawTx)
-	return result, err
+	return swapclient.NewClient(swapServer).AdminCall(rawTx, timeout, reqID)
 }
 
 func loadKeyStore(ctx *cli.Context) error {
@@ -57,3 +59,13 @@ func prepare(ctx *cli.Context) (err error) {
 
 	return nil
 }
+
+// print json string result with indent, returns false if result is not json
+func printIndentedJSON(result interface{}) bool {
+	var out bytes.Buffer
+	if json.Indent(&out, []byte(fmt.Sprint(result)), "", "  ") != nil {
+		return false
+	}
+	fmt.Println(out.String())
+	return true
+}
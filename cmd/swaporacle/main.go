@@ -6,6 +6,7 @@ import (
 	"sort"
 
 	"github.com/anyswap/CrossChain-Bridge/cmd/utils"
+	"github.com/anyswap/CrossChain-Bridge/internal/metrics"
 	"github.com/anyswap/CrossChain-Bridge/log"
 	"github.com/anyswap/CrossChain-Bridge/params"
 	"github.com/anyswap/CrossChain-Bridge/tokens"
@@ -65,6 +66,10 @@ func swaporacle(ctx *cli.Context) error {
 
 	worker.StartWork(false)
 
+	if metricsPort := params.GetConfig().Oracle.MetricsPort; metricsPort > 0 {
+		metrics.StartServer(metricsPort)
+	}
+
 	<-exitCh
 	return nil
 }
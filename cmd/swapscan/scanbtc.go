@@ -9,7 +9,7 @@ import (
 	"github.com/anyswap/CrossChain-Bridge/log"
 	"github.com/anyswap/CrossChain-Bridge/mongodb"
 	"github.com/anyswap/CrossChain-Bridge/params"
-	"github.com/anyswap/CrossChain-Bridge/rpc/client"
+	"github.com/anyswap/CrossChain-Bridge/rpc/swapclient"
 	"github.com/anyswap/CrossChain-Bridge/tokens"
 	"github.com/anyswap/CrossChain-Bridge/tokens/btc"
 	"github.com/anyswap/CrossChain-Bridge/tokens/btc/electrs"
@@ -79,7 +79,8 @@ type btcSwapScanner struct {
 	rpcInterval   time.Duration
 	rpcRetryCount int
 
-	bridge *btc.Bridge
+	bridge       *btc.Bridge
+	serverClient *swapclient.Client
 }
 
 func scanBtc(ctx *cli.Context) error {
@@ -96,6 +97,7 @@ func scanBtc(ctx *cli.Context) error {
 	scanner.endHeight = ctx.Uint64(utils.EndHeightFlag.Name)
 	scanner.stableHeight = ctx.Uint64(utils.StableHeightFlag.Name)
 	scanner.jobCount = ctx.Uint64(utils.JobsFlag.Name)
+	scanner.serverClient = swapclient.NewClient(scanner.swapServer)
 
 	log.Info("get argument success",
 		"testnet", scanner.useTestnet,
@@ -320,13 +322,8 @@ func (scanner *btcSwapScanner) processTx(tx *electrs.ElectTx) {
 	if len(p2shBindAddrs) > 0 {
 		for _, p2shBindAddr := range p2shBindAddrs {
 			log.Info("post p2sh swapin register", "txid", txid, "bind", p2shBindAddr)
-			args := map[string]interface{}{
-				"txid": txid,
-				"bind": p2shBindAddr,
-			}
-			var result interface{}
 			for i := 0; i < scanner.rpcRetryCount; i++ {
-				err = client.RPCPost(&result, scanner.swapServer, "swap.P2shSwapin", args)
+				_, err = scanner.serverClient.P2shSwapin(txid, p2shBindAddr)
 				if tokens.ShouldRegisterSwapForError(err) {
 					break
 				}
@@ -346,13 +343,8 @@ func (scanner *btcSwapScanner) processTx(tx *electrs.ElectTx) {
 			return
 		}
 		log.Info("post swapin register", "txid", txid, "pairid", btc.PairID, "bind", bindAddress)
-		args := map[string]interface{}{
-			"txid":   txid,
-			"pairid": btc.PairID,
-		}
-		var result interface{}
 		for i := 0; i < scanner.rpcRetryCount; i++ {
-			err = client.RPCPost(&result, scanner.swapServer, "swap.Swapin", args)
+			_, err = scanner.serverClient.Swapin(txid, btc.PairID)
 			if tokens.ShouldRegisterSwapForError(err) {
 				break
 			}
@@ -11,7 +11,7 @@ import (
 
 	"github.com/anyswap/CrossChain-Bridge/cmd/utils"
 	"github.com/anyswap/CrossChain-Bridge/log"
-	"github.com/anyswap/CrossChain-Bridge/rpc/client"
+	"github.com/anyswap/CrossChain-Bridge/rpc/swapclient"
 	"github.com/anyswap/CrossChain-Bridge/tokens"
 	"github.com/anyswap/CrossChain-Bridge/tokens/eth"
 	"github.com/anyswap/CrossChain-Bridge/tokens/tools"
@@ -80,8 +80,9 @@ type ethSwapScanner struct {
 	scanReceipt      bool
 	isProxy          bool
 
-	client *ethclient.Client
-	ctx    context.Context
+	client       *ethclient.Client
+	ctx          context.Context
+	serverClient *swapclient.Client
 
 	rpcInterval   time.Duration
 	rpcRetryCount int
@@ -98,6 +99,7 @@ func scanEth(ctx *cli.Context) error {
 	}
 	scanner.gateway = ctx.String(utils.GatewayFlag.Name)
 	scanner.swapServer = ctx.String(utils.SwapServerFlag.Name)
+	scanner.serverClient = swapclient.NewClient(scanner.swapServer)
 	scanner.swapType = ctx.String(utils.SwapTypeFlag.Name)
 	scanner.depositAddresses = ctx.StringSlice(utils.DepositAddressSliceFlag.Name)
 	scanner.tokenAddresses = ctx.StringSlice(utils.TokenAddressSliceFlag.Name)
@@ -192,7 +194,7 @@ func (scanner *ethSwapScanner) init() {
 
 	var version string
 	for i := 0; i < scanner.rpcRetryCount; i++ {
-		err = client.RPCPost(&version, scanner.swapServer, "swap.GetVersionInfo")
+		version, err = scanner.serverClient.GetVersionInfo()
 		if err == nil {
 			log.Info("get server version succeed", "version", version)
 			break
@@ -371,23 +373,21 @@ func (scanner *ethSwapScanner) scanTransaction(tx *types.Transaction) {
 }
 
 func (scanner *ethSwapScanner) postSwap(txid, pairID string) {
-	var subject, rpcMethod string
+	var subject string
 	if scanner.isSwapin {
 		subject = "post swapin register"
-		rpcMethod = "swap.Swapin"
 	} else {
 		subject = "post swapout register"
-		rpcMethod = "swap.Swapout"
 	}
 	log.Info(subject, "txid", txid, "pairID", pairID)
 
-	var result interface{}
-	args := map[string]interface{}{
-		"txid":   txid,
-		"pairid": pairID,
-	}
 	for i := 0; i < scanner.rpcRetryCount; i++ {
-		err := client.RPCPost(&result, scanner.swapServer, rpcMethod, args)
+		var err error
+		if scanner.isSwapin {
+			_, err = scanner.serverClient.Swapin(txid, pairID)
+		} else {
+			_, err = scanner.serverClient.Swapout(txid, pairID)
+		}
 		if tokens.ShouldRegisterSwapForError(err) {
 			break
 		}
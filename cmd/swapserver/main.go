@@ -72,6 +72,7 @@ func swapserver(ctx *cli.Context) error {
 	worker.StartWork(true)
 	time.Sleep(100 * time.Millisecond)
 	rpcserver.StartAPIServer()
+	rpcserver.StartGRPCServer()
 
 	<-exitCh
 	return nil
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/anyswap/CrossChain-Bridge/cmd/utils"
+	"github.com/anyswap/CrossChain-Bridge/log"
+	"github.com/anyswap/CrossChain-Bridge/rpc/apidoc"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	apiDocCommand = &cli.Command{
+		Action:    genAPIDoc,
+		Name:      "apidoc",
+		Usage:     "generate api documents of swap server",
+		ArgsUsage: " ",
+		Description: `
+generate OpenAPI document (openapi.json) of RESTful api,
+and OpenRPC document (openrpc.json) of json rpc api.
+
+Example:
+
+./swaptools apidoc --output ./docs
+`,
+		Flags: []cli.Flag{
+			outputDirFlag,
+		},
+	}
+)
+
+func genAPIDoc(ctx *cli.Context) error {
+	utils.SetLogger(ctx)
+	outputDir := ctx.String(outputDirFlag.Name)
+	writeJSONFile(filepath.Join(outputDir, "openapi.json"), apidoc.GetOpenAPISpec())
+	writeJSONFile(filepath.Join(outputDir, "openrpc.json"), apidoc.GetOpenRPCSpec())
+	return nil
+}
+
+func writeJSONFile(fileName string, content interface{}) {
+	data, err := json.MarshalIndent(content, "", "  ")
+	if err != nil {
+		log.Fatal("marshal json failed", "file", fileName, "err", err)
+	}
+	err = ioutil.WriteFile(fileName, append(data, '\n'), 0644)
+	if err != nil {
+		log.Fatal("write file failed", "file", fileName, "err", err)
+	}
+	log.Info("write api document success", "file", fileName)
+}
@@ -69,4 +69,10 @@ var (
 		Name:  "nonce",
 		Usage: "nonce in transaction",
 	}
+
+	outputDirFlag = &cli.StringFlag{
+		Name:  "output",
+		Usage: "output directory",
+		Value: ".",
+	}
 )
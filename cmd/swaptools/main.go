@@ -27,6 +27,7 @@ func initApp() {
 		sendBtcCommand,
 		sendLtcCommand,
 		sendEthTxCommand,
+		apiDocCommand,
 		utils.LicenseCommand,
 		utils.VersionCommand,
 	}
@@ -24,8 +24,27 @@ func newWrongStatusError(subject, status, errInfo string) error {
 	return fmt.Errorf("[%v] Wrong status \"%v\", err=\"%v\"", subject, status, errInfo)
 }
 
+// postError error of posting to dcrm node, eg. network error
+type postError struct {
+	method string
+	err    error
+}
+
+func (e *postError) Error() string {
+	return fmt.Sprintf("[post] %v error, %v", e.method, e.err)
+}
+
+func (e *postError) Unwrap() error {
+	return e.err
+}
+
 func wrapPostError(method string, err error) error {
-	return fmt.Errorf("[post] %v error, %v", method, err)
+	return &postError{method: method, err: err}
+}
+
+func isPostError(err error) bool {
+	var postErr *postError
+	return errors.As(err, &postErr)
 }
 
 func httpPost(result interface{}, method string, params ...interface{}) error {
@@ -147,3 +166,90 @@ func GetGroupByID(groupID, rpcAddr string) (*GroupInfo, error) {
 	}
 	return result.Data, nil
 }
+
+// CreateGroup call dcrm_createGroup
+func CreateGroup(threshold string, enodes []string, rpcAddr string) (*GroupInfo, error) {
+	var result GetGroupByIDResp
+	err := httpPostTo(&result, rpcAddr, "dcrm_createGroup", threshold, enodes)
+	if err != nil {
+		return nil, wrapPostError("dcrm_createGroup", err)
+	}
+	if result.Status != successStatus {
+		return nil, newWrongStatusError("createGroup", result.Status, result.Error)
+	}
+	return result.Data, nil
+}
+
+// GetReqAddrNonce call dcrm_getReqAddrNonce
+func GetReqAddrNonce(dcrmUser, rpcAddr string) (uint64, error) {
+	var result DataResultResp
+	err := httpPostTo(&result, rpcAddr, "dcrm_getReqAddrNonce", dcrmUser)
+	if err != nil {
+		return 0, wrapPostError("dcrm_getReqAddrNonce", err)
+	}
+	if result.Status != successStatus {
+		return 0, newWrongStatusError("getReqAddrNonce", result.Status, result.Error)
+	}
+	bi, err := common.GetBigIntFromStr(result.Data.Result)
+	if err != nil {
+		return 0, fmt.Errorf("getReqAddrNonce can't parse result as big int, %v", err)
+	}
+	return bi.Uint64(), nil
+}
+
+// ReqDcrmAddr call dcrm_reqDcrmAddr
+func ReqDcrmAddr(raw, rpcAddr string) (string, error) {
+	var result DataResultResp
+	err := httpPostTo(&result, rpcAddr, "dcrm_reqDcrmAddr", raw)
+	if err != nil {
+		return "", wrapPostError("dcrm_reqDcrmAddr", err)
+	}
+	if result.Status != successStatus {
+		return "", newWrongStatusError("reqDcrmAddr", result.Status, result.Error)
+	}
+	return result.Data.Result, nil
+}
+
+// GetReqAddrStatus call dcrm_getReqAddrStatus
+func GetReqAddrStatus(key, rpcAddr string) (*ReqAddrStatus, error) {
+	var result DataResultResp
+	err := httpPostTo(&result, rpcAddr, "dcrm_getReqAddrStatus", key)
+	if err != nil {
+		return nil, wrapPostError("dcrm_getReqAddrStatus", err)
+	}
+	if result.Status != successStatus {
+		return nil, newWrongStatusError("getReqAddrStatus", result.Status, "response error "+result.Error)
+	}
+	var status ReqAddrStatus
+	err = json.Unmarshal([]byte(result.Data.Result), &status)
+	if err != nil {
+		return nil, wrapPostError("dcrm_getReqAddrStatus", err)
+	}
+	return &status, nil
+}
+
+// GetCurNodeReqAddrInfo call dcrm_getCurNodeReqAddrInfo
+func GetCurNodeReqAddrInfo(account, rpcAddr string) ([]*ReqAddrInfoData, error) {
+	var result ReqAddrInfoResp
+	err := httpPostTo(&result, rpcAddr, "dcrm_getCurNodeReqAddrInfo", account)
+	if err != nil {
+		return nil, wrapPostError("dcrm_getCurNodeReqAddrInfo", err)
+	}
+	if result.Status != successStatus {
+		return nil, newWrongStatusError("getCurNodeReqAddrInfo", result.Status, result.Error)
+	}
+	return result.Data, nil
+}
+
+// AcceptReqAddr call dcrm_acceptReqAddr
+func AcceptReqAddr(raw, rpcAddr string) (string, error) {
+	var result DataResultResp
+	err := httpPostTo(&result, rpcAddr, "dcrm_acceptReqAddr", raw)
+	if err != nil {
+		return "", wrapPostError("dcrm_acceptReqAddr", err)
+	}
+	if result.Status != successStatus {
+		return "", newWrongStatusError("acceptReqAddr", result.Status, result.Error)
+	}
+	return result.Data.Result, nil
+}
@@ -0,0 +1,256 @@
+package dcrm
+
+import (
+	"crypto/rand"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// break the circuit after so many consecutive failures
+	maxConsecutiveFailures = 3
+	// keep the circuit broken for this duration, then allow a retry
+	circuitBreakDuration = 5 * time.Minute
+	// weight of the newest sample in the moving average latency
+	latencySmoothFactor = 0.2
+)
+
+var (
+	healthLock   sync.RWMutex
+	nodesHealth  = make(map[string]*HealthStats) // key is rpc address
+	groupsHealth = make(map[string]*HealthStats) // key is sign group id
+)
+
+// HealthStats health statistics of dcrm node or sign group
+type HealthStats struct {
+	SuccessCount        uint64
+	FailureCount        uint64
+	ConsecutiveFailures uint64
+	AvgLatency          int64  // milliseconds
+	LastError           string `json:",omitempty"`
+	LastSuccessTime     int64  `json:",omitempty"`
+	LastFailureTime     int64  `json:",omitempty"`
+	BrokenUntil         int64  `json:",omitempty"`
+}
+
+// NodeHealth dcrm node health info
+type NodeHealth struct {
+	RPCAddress string
+	DcrmUser   string
+	IsBroken   bool
+	*HealthStats
+}
+
+// GroupHealth dcrm sign group health info
+type GroupHealth struct {
+	GroupID  string
+	IsBroken bool
+	*HealthStats
+}
+
+// HealthInfo dcrm health info of all initiator nodes and sign groups
+type HealthInfo struct {
+	Nodes  []*NodeHealth
+	Groups []*GroupHealth
+}
+
+// SuccessRate success rate (with a neutral prior for few samples)
+func (s *HealthStats) SuccessRate() float64 {
+	return float64(s.SuccessCount+1) / float64(s.SuccessCount+s.FailureCount+2)
+}
+
+func (s *HealthStats) isBroken(now time.Time) bool {
+	return s.BrokenUntil > now.Unix()
+}
+
+func (s *HealthStats) recordSuccess(latency time.Duration) {
+	ms := latency.Milliseconds()
+	if s.SuccessCount == 0 {
+		s.AvgLatency = ms
+	} else {
+		s.AvgLatency += int64(latencySmoothFactor * float64(ms-s.AvgLatency))
+	}
+	s.SuccessCount++
+	s.ConsecutiveFailures = 0
+	s.BrokenUntil = 0
+	s.LastSuccessTime = time.Now().Unix()
+}
+
+func (s *HealthStats) recordFailure(err error) {
+	now := time.Now()
+	s.FailureCount++
+	s.ConsecutiveFailures++
+	s.LastFailureTime = now.Unix()
+	if err != nil {
+		s.LastError = err.Error()
+	}
+	if s.ConsecutiveFailures >= maxConsecutiveFailures {
+		s.BrokenUntil = now.Add(circuitBreakDuration).Unix()
+	}
+}
+
+func getOrCreateStats(stats map[string]*HealthStats, key string) *HealthStats {
+	item, exist := stats[key]
+	if !exist {
+		item = &HealthStats{}
+		stats[key] = item
+	}
+	return item
+}
+
+func recordNodeResult(rpcAddr string, latency time.Duration, err error) {
+	healthLock.Lock()
+	defer healthLock.Unlock()
+	stats := getOrCreateStats(nodesHealth, rpcAddr)
+	if err == nil {
+		stats.recordSuccess(latency)
+	} else {
+		stats.recordFailure(err)
+	}
+}
+
+func recordGroupResult(groupID string, latency time.Duration, err error) {
+	healthLock.Lock()
+	defer healthLock.Unlock()
+	stats := getOrCreateStats(groupsHealth, groupID)
+	if err == nil {
+		stats.recordSuccess(latency)
+	} else {
+		stats.recordFailure(err)
+	}
+}
+
+func isNodeBroken(rpcAddr string) bool {
+	healthLock.RLock()
+	defer healthLock.RUnlock()
+	stats, exist := nodesHealth[rpcAddr]
+	return exist && stats.isBroken(time.Now())
+}
+
+// getSignNodesByHealth returns initiator nodes whose circuit is not broken,
+// or all initiator nodes if every one of them is broken.
+func getSignNodesByHealth() []*NodeInfo {
+	nodes := make([]*NodeInfo, 0, len(allInitiatorNodes))
+	for _, dcrmNode := range allInitiatorNodes {
+		if !isNodeBroken(dcrmNode.dcrmRPCAddress) {
+			nodes = append(nodes, dcrmNode)
+		}
+	}
+	if len(nodes) == 0 {
+		return allInitiatorNodes
+	}
+	return nodes
+}
+
+// getSignGroupsByHealth returns sign group indexes in preferred order.
+// healthy groups come first, then groups with higher success rate,
+// then groups with lower latency. ties are broken randomly.
+func getSignGroupsByHealth(signGroups []string) []int {
+	count := len(signGroups)
+	indexes := make([]int, count)
+	for i := range indexes {
+		indexes[i] = i
+	}
+	// shuffle first to break ties randomly
+	for i := count - 1; i > 0; i-- {
+		j, _ := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		k := int(j.Int64())
+		indexes[i], indexes[k] = indexes[k], indexes[i]
+	}
+
+	now := time.Now()
+	healthLock.RLock()
+	stats := make([]HealthStats, count)
+	for i, groupID := range signGroups {
+		if item, exist := groupsHealth[groupID]; exist {
+			stats[i] = *item
+		}
+	}
+	healthLock.RUnlock()
+
+	sort.SliceStable(indexes, func(i, j int) bool {
+		si, sj := &stats[indexes[i]], &stats[indexes[j]]
+		bi, bj := si.isBroken(now), sj.isBroken(now)
+		if bi != bj {
+			return !bi
+		}
+		ri, rj := si.SuccessRate(), sj.SuccessRate()
+		if ri != rj {
+			return ri > rj
+		}
+		return si.AvgLatency < sj.AvgLatency
+	})
+	return indexes
+}
+
+// GetHealthInfo get health info of all initiator nodes and sign groups
+func GetHealthInfo() *HealthInfo {
+	now := time.Now()
+	healthLock.RLock()
+	defer healthLock.RUnlock()
+
+	info := &HealthInfo{}
+	groupsAdded := make(map[string]struct{})
+	for _, dcrmNode := range allInitiatorNodes {
+		stats := HealthStats{}
+		if item, exist := nodesHealth[dcrmNode.dcrmRPCAddress]; exist {
+			stats = *item
+		}
+		info.Nodes = append(info.Nodes, &NodeHealth{
+			RPCAddress:  dcrmNode.dcrmRPCAddress,
+			DcrmUser:    dcrmNode.dcrmUser.String(),
+			IsBroken:    stats.isBroken(now),
+			HealthStats: &stats,
+		})
+		for _, groupID := range dcrmNode.signGroups {
+			if _, exist := groupsAdded[groupID]; exist {
+				continue
+			}
+			groupsAdded[groupID] = struct{}{}
+			groupStats := HealthStats{}
+			if item, exist := groupsHealth[groupID]; exist {
+				groupStats = *item
+			}
+			info.Groups = append(info.Groups, &GroupHealth{
+				GroupID:     groupID,
+				IsBroken:    groupStats.isBroken(now),
+				HealthStats: &groupStats,
+			})
+		}
+	}
+	return info
+}
+
+// NodeReachability dcrm node reachability info
+type NodeReachability struct {
+	RPCAddress string
+	Reachable  bool
+	Latency    int64  // milliseconds
+	Error      string `json:",omitempty"`
+}
+
+// CheckNodesReachability ping every initiator node once.
+// it does not affect the health statistics used in selecting sign nodes.
+func CheckNodesReachability() []*NodeReachability {
+	result := make([]*NodeReachability, len(allInitiatorNodes))
+	var wg sync.WaitGroup
+	for i, dcrmNode := range allInitiatorNodes {
+		wg.Add(1)
+		go func(i int, rpcAddr string) {
+			defer wg.Done()
+			start := time.Now()
+			_, err := GetEnode(rpcAddr)
+			item := &NodeReachability{RPCAddress: rpcAddr, Reachable: err == nil}
+			if err == nil {
+				item.Latency = time.Since(start).Milliseconds()
+			} else {
+				item.Error = err.Error()
+			}
+			result[i] = item
+		}(i, dcrmNode.dcrmRPCAddress)
+	}
+	wg.Wait()
+	return result
+}
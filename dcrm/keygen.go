@@ -0,0 +1,99 @@
+package dcrm
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/anyswap/CrossChain-Bridge/common"
+	"github.com/anyswap/CrossChain-Bridge/log"
+)
+
+const (
+	keygenTimeout = 300 * time.Second
+)
+
+var (
+	errKeygenTimeout = errors.New("keygen timeout")
+	errKeygenFailed  = errors.New("keygen failed")
+)
+
+// NewNodeInfo new dcrm node info with rpc address and keystore (used by tools)
+func NewNodeInfo(rpcAddr, keyfile, passfile string) (*NodeInfo, error) {
+	nodeInfo := &NodeInfo{}
+	nodeInfo.setDcrmRPCAddress(rpcAddr)
+	if keyfile != "" {
+		if _, err := nodeInfo.LoadKeyStore(keyfile, passfile); err != nil {
+			return nil, err
+		}
+	}
+	return nodeInfo, nil
+}
+
+// DoReqDcrmAddr request dcrm address (key generation), returns key id
+func DoReqDcrmAddr(nodeInfo *NodeInfo, groupID, threshold, mode string) (keyID string, err error) {
+	rpcAddr := nodeInfo.dcrmRPCAddress
+	nonce, err := GetReqAddrNonce(nodeInfo.dcrmUser.String(), rpcAddr)
+	if err != nil {
+		return "", err
+	}
+	txdata := ReqAddrData{
+		TxType:    "REQDCRMADDR",
+		Keytype:   "ECDSA",
+		GroupID:   groupID,
+		ThresHold: threshold,
+		Mode:      mode,
+		TimeStamp: common.NowMilliStr(),
+	}
+	payload, _ := json.Marshal(txdata)
+	rawTX, err := BuildDcrmRawTx(nonce, payload, nodeInfo.keyWrapper)
+	if err != nil {
+		return "", err
+	}
+	return ReqDcrmAddr(rawTX, rpcAddr)
+}
+
+// GetReqAddrResult wait until key generation finished and returns the public key
+func GetReqAddrResult(keyID, rpcAddr string) (pubkey string, err error) {
+	log.Info("start get keygen status", "keyID", keyID)
+	deadline := time.Now().Add(keygenTimeout)
+	for time.Now().Before(deadline) {
+		var status *ReqAddrStatus
+		status, err = GetReqAddrStatus(keyID, rpcAddr)
+		if err == nil {
+			switch status.Status {
+			case successStatus:
+				log.Info("get keygen status success", "keyID", keyID, "pubkey", status.PubKey)
+				return status.PubKey, nil
+			case "Failure", "Timeout":
+				log.Info("get keygen status failed", "keyID", keyID, "status", status.Status, "err", status.Error)
+				return "", errKeygenFailed
+			}
+		}
+		time.Sleep(3 * time.Second)
+	}
+	if err == nil {
+		err = errKeygenTimeout
+	}
+	return "", err
+}
+
+// DoAcceptReqAddr accept request dcrm address (key generation)
+func DoAcceptReqAddr(nodeInfo *NodeInfo, keyID, agreeResult string) (string, error) {
+	nonce := uint64(0)
+	data := AcceptReqAddrData{
+		TxType:    "ACCEPTREQADDR",
+		Key:       keyID,
+		Accept:    agreeResult,
+		TimeStamp: common.NowMilliStr(),
+	}
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+	rawTX, err := BuildDcrmRawTx(nonce, payload, nodeInfo.keyWrapper)
+	if err != nil {
+		return "", err
+	}
+	return AcceptReqAddr(rawTX, nodeInfo.dcrmRPCAddress)
+}
@@ -1,7 +1,6 @@
 package dcrm
 
 import (
-	"crypto/rand"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -9,6 +8,7 @@ import (
 	"time"
 
 	"github.com/anyswap/CrossChain-Bridge/common"
+	"github.com/anyswap/CrossChain-Bridge/internal/metrics"
 	"github.com/anyswap/CrossChain-Bridge/log"
 	"github.com/anyswap/CrossChain-Bridge/params"
 	"github.com/anyswap/CrossChain-Bridge/tools/crypto"
@@ -31,12 +31,15 @@ var (
 func pingDcrmNode(nodeInfo *NodeInfo) (err error) {
 	rpcAddr := nodeInfo.dcrmRPCAddress
 	for j := 0; j < pingCount; j++ {
+		start := time.Now()
 		_, err = GetEnode(rpcAddr)
 		if err == nil {
+			recordNodeResult(rpcAddr, time.Since(start), nil)
 			return nil
 		}
 		time.Sleep(1 * time.Second)
 	}
+	recordNodeResult(rpcAddr, 0, err)
 	log.Error("pingDcrmNode failed", "rpcAddr", rpcAddr, "pingCount", pingCount, "err", err)
 	return err
 }
@@ -55,24 +58,29 @@ func DoSign(signPubkey string, msgHash, msgContext []string) (keyID string, rsvs
 	if signPubkey == "" {
 		return "", nil, errors.New("dcrm sign with empty public key")
 	}
+	if _, err = parseSignPubkey(signPubkey); err != nil {
+		return "", nil, err
+	}
+	for _, hash := range msgHash {
+		if len(common.FromHex(hash)) != common.HashLength {
+			return "", nil, fmt.Errorf("dcrm sign with wrong msg hash %v", hash)
+		}
+	}
 	for {
-		for _, dcrmNode := range allInitiatorNodes {
+		// skip circuit broken nodes, and try healthy subgroups first
+		for _, dcrmNode := range getSignNodesByHealth() {
 			if err = pingDcrmNode(dcrmNode); err != nil {
 				continue
 			}
-			signGroupsCount := int64(len(dcrmNode.signGroups))
-			// randomly pick first subgroup to sign
-			randIndex, _ := rand.Int(rand.Reader, big.NewInt(signGroupsCount))
-			startIndex := randIndex.Int64()
-			i := startIndex
-			for {
+			for _, i := range getSignGroupsByHealth(dcrmNode.signGroups) {
 				keyID, rsvs, err = doSignImpl(dcrmNode, i, signPubkey, msgHash, msgContext)
 				if err == nil {
 					return keyID, rsvs, nil
 				}
-				i = (i + 1) % signGroupsCount
-				if i == startIndex {
-					break
+				// do not retry if dcrm returns bad signature
+				if errors.Is(err, ErrBadSignature) {
+					log.Error("dcrm sign returns bad signature", "keyID", keyID, "groupID", dcrmNode.signGroups[i], "err", err)
+					return keyID, nil, err
 				}
 			}
 		}
@@ -80,9 +88,21 @@ func DoSign(signPubkey string, msgHash, msgContext []string) (keyID string, rsvs
 	}
 }
 
-func doSignImpl(dcrmNode *NodeInfo, signGroupIndex int64, signPubkey string, msgHash, msgContext []string) (keyID string, rsvs []string, err error) {
+func doSignImpl(dcrmNode *NodeInfo, signGroupIndex int, signPubkey string, msgHash, msgContext []string) (keyID string, rsvs []string, err error) {
+	signGroupID := dcrmNode.signGroups[signGroupIndex]
+	start := time.Now()
+	// only failures of network, timeout and dcrm nodes are counted against the group,
+	// not sign requests refused for their content (eg. oracles disagree with msg context)
+	var isGroupFailure bool
+	defer func() {
+		if err == nil || isGroupFailure {
+			recordGroupResult(signGroupID, time.Since(start), err)
+		}
+		metrics.RecordDcrmSign(signGroupID, time.Since(start), err)
+	}()
 	nonce, err := GetSignNonce(dcrmNode.dcrmUser.String(), dcrmNode.dcrmRPCAddress)
 	if err != nil {
+		isGroupFailure = true
 		return "", nil, err
 	}
 	txdata := SignData{
@@ -91,7 +111,7 @@ func doSignImpl(dcrmNode *NodeInfo, signGroupIndex int64, signPubkey string, msg
 		MsgHash:    msgHash,
 		MsgContext: msgContext,
 		Keytype:    "ECDSA",
-		GroupID:    dcrmNode.signGroups[signGroupIndex],
+		GroupID:    signGroupID,
 		ThresHold:  dcrmThreshold,
 		Mode:       dcrmMode,
 		TimeStamp:  common.NowMilliStr(),
@@ -105,13 +125,22 @@ func doSignImpl(dcrmNode *NodeInfo, signGroupIndex int64, signPubkey string, msg
 	rpcAddr := dcrmNode.dcrmRPCAddress
 	keyID, err = Sign(rawTX, rpcAddr)
 	if err != nil {
+		// wrong status means the sign request is rejected
+		isGroupFailure = isPostError(err)
 		return "", nil, err
 	}
 
 	rsvs, err = getSignResult(keyID, rpcAddr)
 	if err != nil {
+		// sign failure status means other oracles refuse to accept the sign
+		isGroupFailure = !errors.Is(err, ErrGetSignStatusFailed)
 		return "", nil, err
 	}
+	rsvs, err = VerifySignatures(signPubkey, msgHash, rsvs)
+	if err != nil {
+		isGroupFailure = true
+		return keyID, nil, err
+	}
 	return keyID, rsvs, nil
 }
 
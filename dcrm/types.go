@@ -104,3 +104,52 @@ type GetGroupByIDResp struct {
 	Error  string
 	Data   *GroupInfo
 }
+
+// ReqAddrData request dcrm address (key generation) data
+type ReqAddrData struct {
+	TxType    string
+	Keytype   string
+	GroupID   string
+	ThresHold string
+	Mode      string
+	TimeStamp string
+	Sigs      string
+}
+
+// AcceptReqAddrData accept request dcrm address data
+type AcceptReqAddrData struct {
+	TxType    string
+	Key       string
+	Accept    string
+	TimeStamp string
+}
+
+// ReqAddrStatus request dcrm address status
+type ReqAddrStatus struct {
+	Status    string
+	PubKey    string
+	Tip       string
+	Error     string
+	AllReply  []*SignReply
+	TimeStamp string
+}
+
+// ReqAddrInfoData request dcrm address info
+type ReqAddrInfoData struct {
+	Account   string
+	Cointype  string
+	GroupID   string
+	Key       string
+	Mode      string
+	Nonce     string
+	ThresHold string
+	TimeStamp string
+}
+
+// ReqAddrInfoResp request dcrm address info response
+type ReqAddrInfoResp struct {
+	Status string
+	Tip    string
+	Error  string
+	Data   []*ReqAddrInfoData
+}
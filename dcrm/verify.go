@@ -0,0 +1,133 @@
+package dcrm
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/anyswap/CrossChain-Bridge/common"
+	"github.com/anyswap/CrossChain-Bridge/tools/crypto"
+)
+
+// ErrBadSignature dcrm sign result is malformed or not signed by the sign public key
+var ErrBadSignature = errors.New("dcrm returns bad signature")
+
+// BadSignaturesError dcrm sign result of some msg hashes are bad,
+// which is ErrBadSignature by errors.Is
+type BadSignaturesError struct {
+	Indexes []int // indexes of msg hashes without valid rsv
+	Reason  string
+}
+
+// Error error interface
+func (e *BadSignaturesError) Error() string {
+	return fmt.Sprintf("%v: %v", ErrBadSignature, e.Reason)
+}
+
+// Unwrap unwrap to ErrBadSignature
+func (e *BadSignaturesError) Unwrap() error {
+	return ErrBadSignature
+}
+
+// VerifySignature verify rsv is a valid low-S signature of msgHash signed by signPubkey
+func VerifySignature(signPubkey, msgHash, rsv string) error {
+	pubKey, err := parseSignPubkey(signPubkey)
+	if err != nil {
+		return err
+	}
+	return verifySignature(pubKey.X, pubKey.Y, msgHash, rsv)
+}
+
+func parseSignPubkey(signPubkey string) (pubKey *ecdsa.PublicKey, err error) {
+	pkData := common.FromHex(signPubkey)
+	if len(pkData) == 33 {
+		pubKey, err = crypto.DecompressPubkey(pkData)
+	} else {
+		pubKey, err = crypto.UnmarshalPubkey(pkData)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("wrong sign public key %v, %v", signPubkey, err)
+	}
+	return pubKey, nil
+}
+
+func verifySignature(pubX, pubY *big.Int, msgHash, rsv string) error {
+	sig := common.FromHex(rsv)
+	if len(sig) != crypto.SignatureLength {
+		return fmt.Errorf("%w: wrong length %v of rsv %v", ErrBadSignature, len(sig), rsv)
+	}
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:64])
+	if !crypto.ValidateSignatureValues(sig[64], r, s, true) {
+		return fmt.Errorf("%w: invalid or high-S rsv %v", ErrBadSignature, rsv)
+	}
+	hash := common.FromHex(msgHash)
+	if len(hash) != common.HashLength {
+		return fmt.Errorf("wrong length %v of msg hash %v", len(hash), msgHash)
+	}
+	recovered, err := crypto.SigToPub(hash, sig)
+	if err != nil {
+		return fmt.Errorf("%w: recover public key failed, %v", ErrBadSignature, err)
+	}
+	if recovered.X.Cmp(pubX) != 0 || recovered.Y.Cmp(pubY) != 0 {
+		return fmt.Errorf("%w: rsv %v of msg hash %v is not signed by sign public key", ErrBadSignature, rsv, msgHash)
+	}
+	return nil
+}
+
+// VerifySignatures verify every msgHash has a valid rsv signed by signPubkey,
+// and returns rsvs in the same order of msgHashes (dcrm may return unordered rsvs).
+// returns *BadSignaturesError with indexes of the msg hashes without valid rsv.
+func VerifySignatures(signPubkey string, msgHashes, rsvs []string) (orderedRsvs []string, err error) {
+	pubKey, err := parseSignPubkey(signPubkey)
+	if err != nil {
+		return nil, err
+	}
+	matched := make([]bool, len(rsvs))
+	orderedRsvs = make([]string, 0, len(msgHashes))
+	var badIndexes []int
+	for j, msgHash := range msgHashes {
+		found := false
+		for i, rsv := range rsvs {
+			if matched[i] {
+				continue
+			}
+			err = verifySignature(pubKey.X, pubKey.Y, msgHash, rsv)
+			if err == nil {
+				matched[i] = true
+				orderedRsvs = append(orderedRsvs, rsv)
+				found = true
+				break
+			}
+			if !errors.Is(err, ErrBadSignature) {
+				return nil, err
+			}
+		}
+		if !found {
+			badIndexes = append(badIndexes, j)
+		}
+	}
+	if len(badIndexes) != 0 {
+		badMsgHashes := make([]string, len(badIndexes))
+		for i, j := range badIndexes {
+			badMsgHashes[i] = msgHashes[j]
+		}
+		return nil, &BadSignaturesError{
+			Indexes: badIndexes,
+			Reason:  fmt.Sprintf("msg hashes %v have no matched rsv", badMsgHashes),
+		}
+	}
+	if len(rsvs) != len(msgHashes) {
+		// all msg hashes are matched, but dcrm returns unknown rsvs
+		indexes := make([]int, len(msgHashes))
+		for i := range indexes {
+			indexes[i] = i
+		}
+		return nil, &BadSignaturesError{
+			Indexes: indexes,
+			Reason:  fmt.Sprintf("require %v rsvs but have %v", len(msgHashes), len(rsvs)),
+		}
+	}
+	return orderedRsvs, nil
+}
@@ -0,0 +1,75 @@
+package dcrm
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/anyswap/CrossChain-Bridge/common"
+	"github.com/anyswap/CrossChain-Bridge/tools/crypto"
+)
+
+func TestVerifySignatures(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	otherKey, _ := crypto.GenerateKey()
+	signPubkey := common.ToHex(crypto.FromECDSAPub(&key.PublicKey))
+	compressedPubkey := common.ToHex(crypto.CompressPubkey(&key.PublicKey))
+
+	msgHashes := make([]string, 3)
+	rsvs := make([]string, 3)
+	for i := range msgHashes {
+		hash := crypto.Keccak256Hash([]byte{byte(i)})
+		sig, err := crypto.Sign(hash.Bytes(), key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		msgHashes[i] = hash.Hex()
+		rsvs[i] = common.ToHex(sig)
+	}
+	otherSig, _ := crypto.Sign(common.FromHex(msgHashes[1]), otherKey)
+	otherRsv := common.ToHex(otherSig)
+
+	tests := []struct {
+		pubkey     string
+		msgHashes  []string
+		rsvs       []string
+		ordered    []string
+		badIndexes []int // nil if no bad signature error
+		isErr      bool
+	}{
+		{signPubkey, msgHashes, rsvs, rsvs, nil, false},
+		{compressedPubkey, msgHashes, rsvs, rsvs, nil, false},
+		{signPubkey, msgHashes, []string{rsvs[2], rsvs[0], rsvs[1]}, rsvs, nil, false},
+		{signPubkey, msgHashes, []string{rsvs[0], otherRsv, rsvs[2]}, nil, []int{1}, true},
+		{signPubkey, msgHashes, []string{rsvs[0], "0x1234", rsvs[2]}, nil, []int{1}, true},
+		{signPubkey, msgHashes, []string{rsvs[0], rsvs[0], rsvs[0]}, nil, []int{1, 2}, true},
+		{signPubkey, msgHashes, rsvs[:2], nil, []int{2}, true},
+		{signPubkey, msgHashes[:2], rsvs, nil, []int{0, 1}, true},
+		{signPubkey, []string{"0x1234"}, rsvs[:1], nil, nil, true},
+		{"0x1234", msgHashes, rsvs, nil, nil, true},
+	}
+	for i, test := range tests {
+		ordered, err := VerifySignatures(test.pubkey, test.msgHashes, test.rsvs)
+		if !test.isErr {
+			if err != nil {
+				t.Errorf("test %d: VerifySignatures error: %v", i, err)
+			} else if !reflect.DeepEqual(ordered, test.ordered) {
+				t.Errorf("test %d: VerifySignatures = %v, want %v", i, ordered, test.ordered)
+			}
+			continue
+		}
+		if err == nil {
+			t.Errorf("test %d: VerifySignatures expect error", i)
+			continue
+		}
+		var badErr *BadSignaturesError
+		isBad := errors.As(err, &badErr)
+		if isBad != (test.badIndexes != nil) || isBad != errors.Is(err, ErrBadSignature) {
+			t.Errorf("test %d: VerifySignatures wrong error type: %v", i, err)
+			continue
+		}
+		if isBad && !reflect.DeepEqual(badErr.Indexes, test.badIndexes) {
+			t.Errorf("test %d: VerifySignatures bad indexes = %v, want %v", i, badErr.Indexes, test.badIndexes)
+		}
+	}
+}
@@ -0,0 +1,255 @@
+// Package health checks liveness and readiness of swap server
+package health
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/anyswap/CrossChain-Bridge/dcrm"
+	"github.com/anyswap/CrossChain-Bridge/mongodb"
+	"github.com/anyswap/CrossChain-Bridge/params"
+	"github.com/anyswap/CrossChain-Bridge/rpc/apitypes"
+	"github.com/anyswap/CrossChain-Bridge/tokens"
+)
+
+// health status
+const (
+	HealthStatusOK   = apitypes.HealthStatusOK
+	HealthStatusFail = apitypes.HealthStatusFail
+)
+
+var (
+	defaultMaxBlockAge int64  = 600 // seconds
+	defaultMaxScanLag  uint64 = 100 // blocks
+
+	// job is considered dead if it does not start a new loop in this duration
+	maxJobIdleTime = 30 * time.Minute
+
+	// cache readiness result to avoid flooding gateways and dcrm nodes by probes
+	readinessCacheTime = 5 * time.Second
+
+	jobHeartbeats     = make(map[string]int64) // key is job name, value is unix time
+	jobHeartbeatsLock sync.RWMutex
+
+	readinessCache     *HealthResult
+	readinessCacheLock sync.Mutex
+)
+
+// ComponentHealth type alias
+type ComponentHealth = apitypes.ComponentHealth
+
+// HealthResult type alias
+type HealthResult = apitypes.HealthResult
+
+// GatewayHealthDetails gateway health details
+type GatewayHealthDetails struct {
+	LatestBlock    uint64 `json:"latestblock"`
+	LastUpdateTime int64  `json:"lastupdatetime"`
+	BlockAge       int64  `json:"blockage"` // seconds
+	MaxBlockAge    int64  `json:"maxblockage"`
+}
+
+// ScanHealthDetails scan health details
+type ScanHealthDetails struct {
+	LatestBlock  uint64 `json:"latestblock"`
+	ScannedBlock uint64 `json:"scannedblock"`
+	Lag          uint64 `json:"lag"`
+	MaxScanLag   uint64 `json:"maxscanlag"`
+}
+
+// JobHealthDetails worker job health details
+type JobHealthDetails struct {
+	Job           string `json:"job"`
+	LastHeartbeat int64  `json:"lastheartbeat"`
+	Alive         bool   `json:"alive"`
+}
+
+// MarkJobAlive mark worker job alive when it starts a new loop
+func MarkJobAlive(job string) {
+	jobHeartbeatsLock.Lock()
+	defer jobHeartbeatsLock.Unlock()
+	jobHeartbeats[job] = time.Now().Unix()
+}
+
+// RemoveJobHeartbeat remove heartbeat of stopped worker job
+func RemoveJobHeartbeat(job string) {
+	jobHeartbeatsLock.Lock()
+	defer jobHeartbeatsLock.Unlock()
+	delete(jobHeartbeats, job)
+}
+
+func newHealthResult() *HealthResult {
+	return &HealthResult{
+		Status:     HealthStatusOK,
+		Timestamp:  time.Now().Unix(),
+		Components: make(map[string]*ComponentHealth),
+	}
+}
+
+func addComponentHealth(r *HealthResult, name string, component *ComponentHealth) {
+	r.Components[name] = component
+	if component.Status != HealthStatusOK {
+		r.Status = HealthStatusFail
+	}
+}
+
+func newComponentHealth(details interface{}, err error) *ComponentHealth {
+	component := &ComponentHealth{Status: HealthStatusOK, Details: details}
+	if err != nil {
+		component.Status = HealthStatusFail
+		component.Error = err.Error()
+	}
+	return component
+}
+
+// CheckLiveness check liveness of worker jobs
+func CheckLiveness() *HealthResult {
+	result := newHealthResult()
+	addComponentHealth(result, "workers", checkWorkers(false))
+	return result
+}
+
+// CheckReadiness check readiness of all subsystems
+func CheckReadiness() *HealthResult {
+	readinessCacheLock.Lock()
+	defer readinessCacheLock.Unlock()
+	if readinessCache != nil && time.Since(time.Unix(readinessCache.Timestamp, 0)) < readinessCacheTime {
+		return readinessCache
+	}
+
+	result := newHealthResult()
+	var wg sync.WaitGroup
+	var lock sync.Mutex
+	check := func(name string, checker func() *ComponentHealth) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			component := checker()
+			lock.Lock()
+			addComponentHealth(result, name, component)
+			lock.Unlock()
+		}()
+	}
+	check("mongodb", checkMongoDB)
+	check("srcgateway", func() *ComponentHealth { return checkGateway(true) })
+	check("dstgateway", func() *ComponentHealth { return checkGateway(false) })
+	check("dcrm", checkDcrm)
+	check("workers", func() *ComponentHealth { return checkWorkers(true) })
+	wg.Wait()
+
+	// scan lag depends on the latest block height refreshed by gateway checking
+	addComponentHealth(result, "srcscan", checkScanLag(true))
+	addComponentHealth(result, "dstscan", checkScanLag(false))
+
+	readinessCache = result
+	return result
+}
+
+func checkMongoDB() *ComponentHealth {
+	start := time.Now()
+	err := mongodb.Ping()
+	return newComponentHealth(map[string]int64{"latency": time.Since(start).Milliseconds()}, err)
+}
+
+func checkGateway(isSrc bool) *ComponentHealth {
+	bridge := tokens.GetCrossChainBridge(isSrc)
+	if bridge == nil {
+		return newComponentHealth(nil, fmt.Errorf("bridge is not initialized"))
+	}
+	latest, err := bridge.GetLatestBlockNumber()
+	if err != nil {
+		return newComponentHealth(nil, err)
+	}
+	tokens.CmpAndSetLatestBlockHeight(latest, isSrc)
+
+	maxBlockAge := bridge.GetChainConfig().MaxBlockAge
+	if maxBlockAge == 0 {
+		maxBlockAge = defaultMaxBlockAge
+	}
+	lastUpdateTime := tokens.GetLatestBlockUpdateTime(isSrc)
+	details := &GatewayHealthDetails{
+		LatestBlock:    latest,
+		LastUpdateTime: lastUpdateTime,
+		BlockAge:       time.Now().Unix() - lastUpdateTime,
+		MaxBlockAge:    maxBlockAge,
+	}
+	if details.BlockAge > maxBlockAge {
+		err = fmt.Errorf("latest block %v is not advanced in %v seconds", latest, details.BlockAge)
+	}
+	return newComponentHealth(details, err)
+}
+
+func checkScanLag(isSrc bool) *ComponentHealth {
+	bridge := tokens.GetCrossChainBridge(isSrc)
+	if bridge == nil || !bridge.GetChainConfig().EnableScan {
+		return newComponentHealth("scan is disabled", nil)
+	}
+	scanInfo, err := mongodb.FindLatestScanInfo(isSrc)
+	if err != nil {
+		return newComponentHealth(nil, err)
+	}
+	maxScanLag := bridge.GetChainConfig().MaxScanLag
+	if maxScanLag == 0 {
+		maxScanLag = defaultMaxScanLag
+	}
+	latest := tokens.SrcLatestBlockHeight
+	if !isSrc {
+		latest = tokens.DstLatestBlockHeight
+	}
+	details := &ScanHealthDetails{
+		LatestBlock:  latest,
+		ScannedBlock: scanInfo.BlockHeight,
+		MaxScanLag:   maxScanLag,
+	}
+	if latest > scanInfo.BlockHeight {
+		details.Lag = latest - scanInfo.BlockHeight
+	}
+	if details.Lag > maxScanLag {
+		err = fmt.Errorf("scanned block %v lags %v blocks behind", scanInfo.BlockHeight, details.Lag)
+	}
+	return newComponentHealth(details, err)
+}
+
+func checkDcrm() *ComponentHealth {
+	if !params.IsDcrmEnabled() {
+		return newComponentHealth("dcrm is disabled", nil)
+	}
+	nodes := dcrm.CheckNodesReachability()
+	for _, node := range nodes {
+		if node.Reachable {
+			return newComponentHealth(nodes, nil)
+		}
+	}
+	return newComponentHealth(nodes, fmt.Errorf("no dcrm initiator node is reachable"))
+}
+
+func checkWorkers(requireStarted bool) *ComponentHealth {
+	now := time.Now()
+	jobHeartbeatsLock.RLock()
+	details := make([]*JobHealthDetails, 0, len(jobHeartbeats))
+	for job, heartbeat := range jobHeartbeats {
+		details = append(details, &JobHealthDetails{
+			Job:           job,
+			LastHeartbeat: heartbeat,
+			Alive:         now.Sub(time.Unix(heartbeat, 0)) < maxJobIdleTime,
+		})
+	}
+	jobHeartbeatsLock.RUnlock()
+	sort.Slice(details, func(i, j int) bool {
+		return details[i].Job < details[j].Job
+	})
+
+	var err error
+	if requireStarted && len(details) == 0 {
+		err = fmt.Errorf("worker jobs are not started")
+	}
+	for _, job := range details {
+		if !job.Alive {
+			err = fmt.Errorf("worker job %v is not alive", job.Job)
+			break
+		}
+	}
+	return newComponentHealth(details, err)
+}
@@ -0,0 +1,243 @@
+// Package metrics provides prometheus metrics of the bridge.
+package metrics
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/anyswap/CrossChain-Bridge/internal/swapevent"
+	"github.com/anyswap/CrossChain-Bridge/log"
+)
+
+const namespace = "bridge"
+
+var (
+	// counts status transitions from swap events, see swapCounts for current numbers
+	swapStatusCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "swap_status_changes_total",
+		Help:      "Number of swap status changes per pair, swap type, record kind and status.",
+	}, []string{"pairid", "swaptype", "kind", "status"})
+
+	swapCounts = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "swaps",
+		Help:      "Number of swaps per pair, swap type, record kind and status.",
+	}, []string{"pairid", "swaptype", "kind", "status"})
+
+	jobLoopDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "job_loop_duration_seconds",
+		Help:      "Duration of one loop of worker jobs.",
+		Buckets:   prometheus.ExponentialBuckets(0.01, 4, 10),
+	}, []string{"job"})
+
+	dcrmSignDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "dcrm_sign_duration_seconds",
+		Help:      "Duration of successful dcrm sign per sign group.",
+		Buckets:   prometheus.ExponentialBuckets(1, 2, 9),
+	}, []string{"group"})
+
+	dcrmSignFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "dcrm_sign_failures_total",
+		Help:      "Number of failed dcrm sign per sign group.",
+	}, []string{"group"})
+
+	rpcRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "rpc_request_duration_seconds",
+		Help:      "Duration of outgoing rpc requests per endpoint.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"endpoint"})
+
+	rpcRequestFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "rpc_request_failures_total",
+		Help:      "Number of failed outgoing rpc requests per endpoint.",
+	}, []string{"endpoint"})
+
+	latestBlockHeight = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "latest_block_height",
+		Help:      "Latest block height of the chain.",
+	}, []string{"chain"})
+
+	scannedBlockHeight = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "scanned_block_height",
+		Help:      "Latest scanned stable block height of the chain.",
+	}, []string{"chain"})
+
+	swapNonce = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "swap_nonce",
+		Help:      "Next nonce of swap tx per pair and swap type.",
+	}, []string{"pairid", "swaptype"})
+
+	oracleAcceptSign = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "oracle_accept_sign_total",
+		Help:      "Number of dcrm sign accepted by oracle per result (AGREE or DISAGREE).",
+	}, []string{"result"})
+
+	adminDeniedCalls = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "admin_denied_calls_total",
+		Help:      "Number of admin calls denied for lack of permission per method.",
+	}, []string{"method"})
+
+	swapEventStarter sync.Once
+)
+
+func init() {
+	prometheus.MustRegister(
+		swapStatusCounter,
+		swapCounts,
+		jobLoopDuration,
+		dcrmSignDuration,
+		dcrmSignFailures,
+		rpcRequestDuration,
+		rpcRequestFailures,
+		latestBlockHeight,
+		scannedBlockHeight,
+		swapNonce,
+		oracleAcceptSign,
+		adminDeniedCalls,
+	)
+}
+
+// Handler metrics http handler
+func Handler() http.Handler {
+	startSwapStatusCounter()
+	return promhttp.Handler()
+}
+
+// StartServer serve metrics on a standalone port
+func StartServer(port int) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", Handler())
+	log.Info("metrics service listen and serving", "port", port)
+	go func() {
+		if err := http.ListenAndServe(fmt.Sprintf(":%v", port), mux); err != nil {
+			log.Error("metrics ListenAndServe error", "err", err)
+		}
+	}()
+}
+
+func startSwapStatusCounter() {
+	swapEventStarter.Do(func() {
+		sub := swapevent.Subscribe(0)
+		go func() {
+			for ev := range sub.C {
+				swapType, kind := getSwapLabels(ev.IsSwapin, ev.IsResult)
+				swapStatusCounter.WithLabelValues(ev.PairID, swapType, kind, ev.StatusMsg).Inc()
+			}
+		}()
+	})
+}
+
+func getSwapLabels(isSwapin, isResult bool) (swapType, kind string) {
+	swapType = "swapout"
+	if isSwapin {
+		swapType = "swapin"
+	}
+	kind = "swap"
+	if isResult {
+		kind = "result"
+	}
+	return swapType, kind
+}
+
+func getChainLabel(isSrc bool) string {
+	if isSrc {
+		return "src"
+	}
+	return "dest"
+}
+
+// ObserveJobLoop observe duration of one job loop started at start
+func ObserveJobLoop(job string, start time.Time) {
+	jobLoopDuration.WithLabelValues(job).Observe(time.Since(start).Seconds())
+}
+
+// RecordDcrmSign record dcrm sign result of sign group
+func RecordDcrmSign(groupID string, latency time.Duration, err error) {
+	if err != nil {
+		dcrmSignFailures.WithLabelValues(groupID).Inc()
+		return
+	}
+	dcrmSignDuration.WithLabelValues(groupID).Observe(latency.Seconds())
+}
+
+// RecordRPCRequest record outgoing rpc request to rawURL started at start
+func RecordRPCRequest(rawURL string, start time.Time, err error) {
+	endpoint := getEndpoint(rawURL)
+	rpcRequestDuration.WithLabelValues(endpoint).Observe(time.Since(start).Seconds())
+	if err != nil {
+		rpcRequestFailures.WithLabelValues(endpoint).Inc()
+	}
+}
+
+// only keep scheme and host, as path and query may contain api keys
+func getEndpoint(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return "unknown"
+	}
+	return u.Scheme + "://" + u.Host
+}
+
+// SetLatestBlockHeight set latest block height
+func SetLatestBlockHeight(isSrc bool, height uint64) {
+	latestBlockHeight.WithLabelValues(getChainLabel(isSrc)).Set(float64(height))
+}
+
+// SetScannedBlockHeight set scanned block height
+func SetScannedBlockHeight(isSrc bool, height uint64) {
+	scannedBlockHeight.WithLabelValues(getChainLabel(isSrc)).Set(float64(height))
+}
+
+// SetSwapNonce set next swap nonce
+func SetSwapNonce(pairID string, isSwapin bool, nonce uint64) {
+	swapType := "swapout"
+	if isSwapin {
+		swapType = "swapin"
+	}
+	swapNonce.WithLabelValues(pairID, swapType).Set(float64(nonce))
+}
+
+// IncOracleAcceptSign increase oracle accept sign count of result
+func IncOracleAcceptSign(result string) {
+	oracleAcceptSign.WithLabelValues(result).Inc()
+}
+
+// IncAdminDeniedCall increase denied admin call count of method
+func IncAdminDeniedCall(method string) {
+	adminDeniedCalls.WithLabelValues(method).Inc()
+}
+
+// SwapCount number of swaps of a pair in status
+type SwapCount struct {
+	PairID   string
+	IsSwapin bool
+	IsResult bool
+	Status   string
+	Count    int
+}
+
+// SetSwapCounts replace all swap counts with counts
+func SetSwapCounts(counts []*SwapCount) {
+	swapCounts.Reset()
+	for _, c := range counts {
+		swapType, kind := getSwapLabels(c.IsSwapin, c.IsResult)
+		swapCounts.WithLabelValues(c.PairID, swapType, kind, c.Status).Set(float64(c.Count))
+	}
+}
@@ -8,27 +8,20 @@ import (
 	"github.com/anyswap/CrossChain-Bridge/log"
 	"github.com/anyswap/CrossChain-Bridge/mongodb"
 	"github.com/anyswap/CrossChain-Bridge/params"
+	"github.com/anyswap/CrossChain-Bridge/rpc/apierrors"
 	"github.com/anyswap/CrossChain-Bridge/tokens"
 	"github.com/anyswap/CrossChain-Bridge/tokens/btc"
 	"github.com/btcsuite/btcd/txscript"
-	rpcjson "github.com/gorilla/rpc/v2/json2"
 )
 
 var (
-	errNotBtcBridge      = newRPCError(-32096, "bridge is not btc")
-	errTokenPairNotExist = newRPCError(-32095, "token pair not exist")
-	errSwapCannotRetry   = newRPCError(-32094, "swap can not retry")
+	errNotBtcBridge      = apierrors.NotBtcBridge.New("bridge is not btc")
+	errTokenPairNotExist = apierrors.TokenPairNotExist.New("token pair not exist")
+	errSwapCannotRetry   = apierrors.SwapCannotRetry.New("swap can not retry")
 )
 
-func newRPCError(ec rpcjson.ErrorCode, message string) error {
-	return &rpcjson.Error{
-		Code:    ec,
-		Message: message,
-	}
-}
-
 func newRPCInternalError(err error) error {
-	return newRPCError(-32000, "rpcError: "+err.Error())
+	return apierrors.InternalError.Wrap("rpcError: ", err)
 }
 
 // GetServerInfo api
@@ -45,6 +38,7 @@ func GetServerInfo() (*ServerInfo, error) {
 		DestChain:           config.DestChain,
 		PairIDs:             tokens.GetAllPairIDs(),
 		Version:             params.VersionWithMeta,
+		MaintenanceWindows:  getMaintenanceWindows(),
 	}, nil
 }
 
@@ -165,7 +159,7 @@ func RetrySwapin(txid, pairID *string) (*PostResult, error) {
 	pairIDStr := *pairID
 	swapInfo, err := tokens.SrcBridge.VerifyTransaction(pairIDStr, txidstr, true)
 	if err != nil {
-		return nil, newRPCError(-32099, "retry swapin failed! "+err.Error())
+		return nil, apierrors.VerifySwapFailed.Wrap("retry swapin failed! ", err)
 	}
 	bindStr := swapInfo.Bind
 	swap, _ := mongodb.FindSwapin(txidstr, pairIDStr, bindStr)
@@ -191,6 +185,9 @@ func Swapout(txid, pairID *string) (*PostResult, error) {
 func swap(txid, pairID *string, isSwapin bool) (*PostResult, error) {
 	txidstr := *txid
 	pairIDStr := *pairID
+	if window := getActiveMaintenanceWindow(pairIDStr, isSwapin); window != nil {
+		return queueSwapRegister(txidstr, pairIDStr, isSwapin, window)
+	}
 	bridge := tokens.GetCrossChainBridge(isSwapin)
 	swapInfo, err := bridge.VerifyTransaction(pairIDStr, txidstr, true)
 	if err != nil {
@@ -219,7 +216,7 @@ func swap(txid, pairID *string, isSwapin bool) (*PostResult, error) {
 
 func addSwapToDatabase(txid string, txType tokens.SwapTxType, swapInfo *tokens.TxSwapInfo, verifyError error) (err error) {
 	if !tokens.ShouldRegisterSwapForError(verifyError) {
-		return newRPCError(-32099, "verify swap failed! "+verifyError.Error())
+		return apierrors.VerifySwapFailed.Wrap("verify swap failed! ", verifyError)
 	}
 	var memo string
 	if verifyError != nil {
@@ -311,7 +308,7 @@ func P2shSwapin(txid, bindAddr *string) (*PostResult, error) {
 	}
 	swapInfo, err := btc.BridgeInstance.VerifyP2shTransaction(pairID, txidstr, *bindAddr, true)
 	if !tokens.ShouldRegisterSwapForError(err) {
-		return nil, newRPCError(-32099, "verify p2sh swapin failed! "+err.Error())
+		return nil, apierrors.VerifySwapFailed.Wrap("verify p2sh swapin failed! ", err)
 	}
 	var memo string
 	if err != nil {
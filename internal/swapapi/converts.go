@@ -12,7 +12,7 @@ func ConvertMgoSwapToSwapInfo(ms *mongodb.MgoSwap) *SwapInfo {
 		TxID:      ms.TxID,
 		TxTo:      ms.TxTo,
 		Bind:      ms.Bind,
-		Status:    ms.Status,
+		Status:    SwapStatus(ms.Status),
 		StatusMsg: ms.Status.String(),
 		InitTime:  ms.InitTime,
 		Timestamp: ms.Timestamp,
@@ -60,7 +60,7 @@ func ConvertMgoSwapResultToSwapInfo(mr *mongodb.MgoSwapResult) *SwapInfo {
 		SwapValue:     mr.SwapValue,
 		SwapType:      mr.SwapType,
 		SwapNonce:     mr.SwapNonce,
-		Status:        mr.Status,
+		Status:        SwapStatus(mr.Status),
 		StatusMsg:     mr.Status.String(),
 		InitTime:      mr.InitTime,
 		Timestamp:     mr.Timestamp,
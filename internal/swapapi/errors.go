@@ -0,0 +1,49 @@
+package swapapi
+
+import (
+	"github.com/anyswap/CrossChain-Bridge/rpc/apierrors"
+	"github.com/anyswap/CrossChain-Bridge/tokens"
+)
+
+// bind errors of bridges to api error catalogue,
+// errors of mongodb have the same codes as their entries.
+func init() {
+	apierrors.BindError(-32100, tokens.ErrSwapTypeNotSupported)
+	apierrors.BindError(-32101, tokens.ErrBridgeSourceNotSupported)
+	apierrors.BindError(-32102, tokens.ErrBridgeDestinationNotSupported)
+	apierrors.BindError(-32103, tokens.ErrUnknownSwapType)
+	apierrors.BindError(-32104, tokens.ErrMsgHashMismatch)
+	apierrors.BindError(-32105, tokens.ErrWrongCountOfMsgHashes)
+	apierrors.BindError(-32106, tokens.ErrWrongRawTx)
+	apierrors.BindError(-32107, tokens.ErrWrongExtraArgs)
+	apierrors.BindError(-32108, tokens.ErrNoBtcBridge)
+	apierrors.BindError(-32109, tokens.ErrWrongSwapinTxType)
+	apierrors.BindError(-32110, tokens.ErrBuildSwapTxInWrongEndpoint)
+	apierrors.BindError(-32111, tokens.ErrTxBeforeInitialHeight)
+	apierrors.BindError(-32112, tokens.ErrAddressIsInBlacklist)
+	apierrors.BindError(-32113, tokens.ErrNotMigrating)
+	apierrors.BindError(-32114, tokens.ErrRetiringHasPendingTxs)
+	apierrors.BindError(-32115, tokens.ErrNothingToSweep)
+	apierrors.BindError(-32116, tokens.ErrTodo)
+	apierrors.BindError(-32117, tokens.ErrTxNotFound)
+	apierrors.BindError(-32118, tokens.ErrTxNotStable)
+	apierrors.BindError(-32119, tokens.ErrTxWithWrongReceiver)
+	apierrors.BindError(-32120, tokens.ErrTxWithWrongContract)
+	apierrors.BindError(-32121, tokens.ErrTxWithWrongInput)
+	apierrors.BindError(-32122, tokens.ErrTxWithWrongLogData)
+	apierrors.BindError(-32123, tokens.ErrTxIsAggregateTx)
+	apierrors.BindError(-32124, tokens.ErrWrongP2shBindAddress)
+	apierrors.BindError(-32125, tokens.ErrTxFuncHashMismatch)
+	apierrors.BindError(-32126, tokens.ErrDepositLogNotFound)
+	apierrors.BindError(-32127, tokens.ErrSwapoutLogNotFound)
+	apierrors.BindError(-32128, tokens.ErrUnknownPairID)
+	apierrors.BindError(-32129, tokens.ErrBindAddressMismatch)
+	apierrors.BindError(-32130, tokens.ErrTxWithWrongMemo)
+	apierrors.BindError(-32131, tokens.ErrTxWithWrongValue)
+	apierrors.BindError(-32132, tokens.ErrTxWithWrongReceipt)
+	apierrors.BindError(-32133, tokens.ErrTxWithWrongSender)
+	apierrors.BindError(-32134, tokens.ErrTxSenderNotRegistered)
+	apierrors.BindError(-32135, tokens.ErrTxIncompatible)
+	apierrors.BindError(-32136, tokens.ErrBindAddrIsContract)
+	apierrors.BindError(-32137, tokens.ErrRPCQueryError)
+}
@@ -0,0 +1,120 @@
+package swapapi
+
+import (
+	"encoding/hex"
+	"sort"
+	"strings"
+
+	"github.com/anyswap/CrossChain-Bridge/common"
+	"github.com/anyswap/CrossChain-Bridge/log"
+	"github.com/anyswap/CrossChain-Bridge/mongodb"
+	"github.com/anyswap/CrossChain-Bridge/rpc/apierrors"
+	"github.com/anyswap/CrossChain-Bridge/tokens"
+)
+
+const (
+	maxProcessQueuedRegisters = 100
+	maxQueuedRegisterAttempts = 3
+	maxQueuedRegistersPerPair = 10000 // of each direction
+)
+
+var errQueueIsFull = apierrors.ServiceUnavailable.New("swap register queue is full, please retry after maintenance")
+
+// get maintenance windows of all token pairs which are not ended
+func getMaintenanceWindows() []*tokens.MaintenanceWindow {
+	var windows []*tokens.MaintenanceWindow
+	exist := make(map[string]bool)
+	for _, pairCfg := range tokens.GetTokenPairsConfig() {
+		for _, tokenCfg := range []*tokens.TokenConfig{pairCfg.SrcToken, pairCfg.DestToken} {
+			for _, window := range tokenCfg.GetMaintenanceWindows() {
+				if !exist[window.ID] {
+					exist[window.ID] = true
+					windows = append(windows, window)
+				}
+			}
+		}
+	}
+	sort.Slice(windows, func(i, j int) bool {
+		return windows[i].StartTime < windows[j].StartTime
+	})
+	return windows
+}
+
+func getActiveMaintenanceWindow(pairID string, isSwapin bool) *tokens.MaintenanceWindow {
+	fromTokenCfg, _ := tokens.GetTokenConfigsByDirection(pairID, isSwapin)
+	if fromTokenCfg == nil {
+		return nil
+	}
+	return fromTokenCfg.GetActiveMaintenanceWindow()
+}
+
+// all supported chains use 32 bytes tx hash, with or without '0x' prefix
+func isValidTxHash(txid string) bool {
+	hash := strings.TrimPrefix(strings.TrimPrefix(txid, "0x"), "0X")
+	if len(hash) != 2*common.HashLength {
+		return false
+	}
+	_, err := hex.DecodeString(hash)
+	return err == nil
+}
+
+// queue swap register as it can not be verified in maintenance window,
+// the register is validated and the queue is limited, as the api is public.
+func queueSwapRegister(txid, pairID string, isSwapin bool, window *tokens.MaintenanceWindow) (*PostResult, error) {
+	if !isValidTxHash(txid) {
+		return nil, apierrors.InvalidArgument.Errorf("wrong tx hash '%v'", txid)
+	}
+	count, err := mongodb.GetQueuedRegisterCount(pairID, isSwapin)
+	if err != nil {
+		return nil, err
+	}
+	if count >= maxQueuedRegistersPerPair {
+		log.Warn("[api] swap register queue is full", "pairID", pairID, "isSwapin", isSwapin, "count", count)
+		return nil, errQueueIsFull
+	}
+	err = mongodb.AddQueuedRegister(txid, pairID, isSwapin)
+	if err != nil && err != mongodb.ErrItemIsDup {
+		return nil, err
+	}
+	log.Info("[api] queue swap register in maintenance window", "txid", txid, "pairID", pairID, "isSwapin", isSwapin, "window", window.ID, "end", window.EndTime)
+	return &QueuedPostResult, nil
+}
+
+// get pairIDs of swapin and swapout directions which are in maintenance now
+func getPairIDsInMaintenance() (swapinPairIDs, swapoutPairIDs []string) {
+	for _, pairCfg := range tokens.GetTokenPairsConfig() {
+		pairID := strings.ToLower(pairCfg.PairID)
+		if pairCfg.SrcToken.GetActiveMaintenanceWindow() != nil {
+			swapinPairIDs = append(swapinPairIDs, pairID)
+		}
+		if pairCfg.DestToken.GetActiveMaintenanceWindow() != nil {
+			swapoutPairIDs = append(swapoutPairIDs, pairID)
+		}
+	}
+	return swapinPairIDs, swapoutPairIDs
+}
+
+// ProcessQueuedRegisters register the queued swaps whose maintenance window is ended,
+// queued swaps of pairs still in maintenance are excluded when querying,
+// so that they do not block processing of the others.
+func ProcessQueuedRegisters() {
+	swapinPairIDs, swapoutPairIDs := getPairIDsInMaintenance()
+	queued, err := mongodb.FindQueuedRegisters(swapinPairIDs, swapoutPairIDs, maxProcessQueuedRegisters)
+	if err != nil {
+		log.Warn("find queued swap registers failed", "err", err)
+		return
+	}
+	for _, item := range queued {
+		if getActiveMaintenanceWindow(item.PairID, item.IsSwapin) != nil {
+			continue
+		}
+		txid, pairID := item.TxID, item.PairID
+		_, err = swap(&txid, &pairID, item.IsSwapin)
+		if err == nil || err == mongodb.ErrItemIsDup || item.Attempts+1 >= maxQueuedRegisterAttempts {
+			log.Info("register queued swap", "txid", txid, "pairID", pairID, "isSwapin", item.IsSwapin, "attempts", item.Attempts+1, "err", err)
+			_ = mongodb.RemoveQueuedRegister(item.Key)
+			continue
+		}
+		_ = mongodb.UpdateQueuedRegisterAttempt(item.Key, err)
+	}
+}
@@ -0,0 +1,124 @@
+package swapapi
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anyswap/CrossChain-Bridge/log"
+	"github.com/anyswap/CrossChain-Bridge/mongodb"
+	"github.com/anyswap/CrossChain-Bridge/tokens"
+)
+
+var (
+	// cache swap nonces to avoid flooding gateways by this public api,
+	// as it queries chain nonces of all signers if pairID is 'all'
+	swapNoncesCacheTime = 10 * time.Second
+	swapNoncesCache     = make(map[string]*swapNoncesCacheItem) // key is pairID or 'all'
+	swapNoncesCacheLock sync.Mutex
+)
+
+type swapNoncesCacheItem struct {
+	result    []*SwapNonceInfo
+	cacheTime time.Time
+}
+
+// GetSwapNonces get swap nonces of signers of pair (or all pairs if pairID is 'all')
+// in database and on chain, the chain not supporting nonce is skipped.
+func GetSwapNonces(pairID string) ([]*SwapNonceInfo, error) {
+	log.Debug("[api] receive GetSwapNonces", "pairID", pairID)
+	var pairCfgs []*tokens.TokenPairConfig
+	cacheKey := strings.ToLower(pairID)
+	if pairID == "" || strings.EqualFold(pairID, "all") {
+		cacheKey = "all"
+		for _, pairCfg := range tokens.GetTokenPairsConfig() {
+			pairCfgs = append(pairCfgs, pairCfg)
+		}
+	} else {
+		pairCfg := tokens.GetTokenPairConfig(pairID)
+		if pairCfg == nil {
+			return nil, errTokenPairNotExist
+		}
+		pairCfgs = append(pairCfgs, pairCfg)
+	}
+
+	swapNoncesCacheLock.Lock()
+	defer swapNoncesCacheLock.Unlock()
+	if item, exist := swapNoncesCache[cacheKey]; exist && time.Since(item.cacheTime) < swapNoncesCacheTime {
+		return item.result, nil
+	}
+	result := getSwapNonces(pairCfgs)
+	swapNoncesCache[cacheKey] = &swapNoncesCacheItem{result: result, cacheTime: time.Now()}
+	return result, nil
+}
+
+func getSwapNonces(pairCfgs []*tokens.TokenPairConfig) []*SwapNonceInfo {
+	nonceInfos := make(map[string]*SwapNonceInfo)
+	addNonceInfo := func(pairID string, tokenCfg *tokens.TokenConfig, isSwapin bool) {
+		address := strings.ToLower(tokenCfg.GetSwapSigner(""))
+		key := getSwapNonceInfoKey(address, isSwapin)
+		if info, exist := nonceInfos[key]; exist {
+			info.PairIDs = append(info.PairIDs, pairID)
+			return
+		}
+		nonceInfos[key] = &SwapNonceInfo{
+			Address:  address,
+			IsSwapin: isSwapin,
+			PairIDs:  []string{pairID},
+		}
+	}
+	_, isSrcNonceSupported := tokens.SrcBridge.(tokens.NonceSetter)
+	_, isDstNonceSupported := tokens.DstBridge.(tokens.NonceSetter)
+	for _, pairCfg := range pairCfgs {
+		pairID := strings.ToLower(pairCfg.PairID)
+		if isDstNonceSupported {
+			addNonceInfo(pairID, pairCfg.DestToken, true)
+		}
+		if isSrcNonceSupported {
+			addNonceInfo(pairID, pairCfg.SrcToken, false)
+		}
+	}
+
+	result := make([]*SwapNonceInfo, 0, len(nonceInfos))
+	for _, info := range nonceInfos {
+		fillSwapNonceInfo(info)
+		sort.Strings(info.PairIDs)
+		result = append(result, info)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].IsSwapin != result[j].IsSwapin {
+			return result[i].IsSwapin
+		}
+		return result[i].Address < result[j].Address
+	})
+	return result
+}
+
+func getSwapNonceInfoKey(address string, isSwapin bool) string {
+	if isSwapin {
+		return address + ":swapin"
+	}
+	return address + ":swapout"
+}
+
+func fillSwapNonceInfo(info *SwapNonceInfo) {
+	latestNonce, err := mongodb.FindLatestSwapNonce(info.Address, info.IsSwapin)
+	if err == nil {
+		info.DBNonce = latestNonce.SwapNonce
+	} else if err != mongodb.ErrItemNotFound {
+		info.Error = err.Error()
+		return
+	}
+	// swapin tx is sent on dest chain, swapout tx is sent on source chain
+	nonceSetter := tokens.GetCrossChainBridge(!info.IsSwapin).(tokens.NonceSetter)
+	info.LatestNonce, err = nonceSetter.GetPoolNonce(info.Address, "latest")
+	if err != nil {
+		info.Error = err.Error()
+		return
+	}
+	info.PendingNonce, err = nonceSetter.GetPoolNonce(info.Address, "pending")
+	if err != nil {
+		info.Error = err.Error()
+	}
+}
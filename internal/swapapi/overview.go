@@ -0,0 +1,275 @@
+package swapapi
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/anyswap/CrossChain-Bridge/log"
+	"github.com/anyswap/CrossChain-Bridge/mongodb"
+	"github.com/anyswap/CrossChain-Bridge/rpc/apierrors"
+	"github.com/anyswap/CrossChain-Bridge/tokens"
+	"github.com/anyswap/CrossChain-Bridge/tokens/btc"
+)
+
+var (
+	// registered swaps which have no swap result yet (or are reverifying)
+	registerPendingStatuses = []mongodb.SwapStatus{
+		mongodb.TxNotStable,
+		mongodb.TxSenderNotRegistered,
+		mongodb.RPCQueryError,
+	}
+	registerFailedStatuses = []mongodb.SwapStatus{
+		mongodb.TxVerifyFailed,
+		mongodb.TxWithWrongSender,
+		mongodb.TxIncompatible,
+		mongodb.SwapInBlacklist,
+		mongodb.ManualMakeFail,
+		mongodb.SwapWithBadSignature,
+	}
+
+	resultPendingStatuses = []mongodb.SwapStatus{
+		mongodb.MatchTxEmpty,
+		mongodb.MatchTxNotStable,
+		mongodb.TxWithBigValue,
+	}
+	resultFailedStatuses = []mongodb.SwapStatus{
+		mongodb.MatchTxFailed,
+		mongodb.TxSwapFailed,
+		mongodb.TxWithWrongMemo,
+		mongodb.TxWithWrongValue,
+		mongodb.BindAddrIsContract,
+	}
+
+	swapStages = map[mongodb.SwapStatus]string{
+		mongodb.TxNotStable:           "waiting for the tx to be stable and verified",
+		mongodb.RPCQueryError:         "verify tx met rpc error, will retry",
+		mongodb.TxSenderNotRegistered: "waiting for the sender to be registered",
+		mongodb.TxWithBigValue:        "big value swap, waiting for manual review",
+		mongodb.TxNotSwapped:          "waiting for the swap tx to be built",
+		mongodb.TxProcessed:           "swap tx is being signed and sent",
+		mongodb.MatchTxNotStable:      "swap tx is sent, waiting for confirmations",
+	}
+)
+
+func containsStatus(statuses []mongodb.SwapStatus, status mongodb.SwapStatus) bool {
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+func getSwapStage(status mongodb.SwapStatus) string {
+	if stage, exist := swapStages[status]; exist {
+		return stage
+	}
+	return status.String()
+}
+
+func getFailedReason(status mongodb.SwapStatus, memo string) string {
+	if memo != "" {
+		return memo
+	}
+	return status.String()
+}
+
+type addressSwapCollector struct {
+	pending []*AddressSwapInfo
+	failed  []*AddressSwapInfo
+	seen    map[string]struct{}
+}
+
+func (c *addressSwapCollector) markSeen(isSwapin bool, key string) bool {
+	if isSwapin {
+		key = "in:" + key
+	} else {
+		key = "out:" + key
+	}
+	if _, exist := c.seen[key]; exist {
+		return false
+	}
+	c.seen[key] = struct{}{}
+	return true
+}
+
+func (c *addressSwapCollector) addPending(isSwapin bool, swap *SwapInfo, stageStatus mongodb.SwapStatus) {
+	c.pending = append(c.pending, &AddressSwapInfo{
+		SwapInfo: swap,
+		IsSwapin: isSwapin,
+		Stage:    getSwapStage(stageStatus),
+	})
+}
+
+func (c *addressSwapCollector) addFailed(isSwapin bool, swap *SwapInfo, status mongodb.SwapStatus, memo string) {
+	c.failed = append(c.failed, &AddressSwapInfo{
+		SwapInfo: swap,
+		IsSwapin: isSwapin,
+		Reason:   getFailedReason(status, memo),
+	})
+}
+
+// registered swaps are matched by bind address, as they have no sender info
+func (c *addressSwapCollector) collectSwaps(isSwapin bool, address string) error {
+	statuses := append(append([]mongodb.SwapStatus{}, registerPendingStatuses...), registerFailedStatuses...)
+	swaps, err := mongodb.FindBindAddressSwaps(isSwapin, address, statuses, maxQueryLimit)
+	if err != nil {
+		return err
+	}
+	for _, swap := range swaps {
+		if !c.markSeen(isSwapin, swap.Key) {
+			continue
+		}
+		if containsStatus(registerFailedStatuses, swap.Status) {
+			c.addFailed(isSwapin, ConvertMgoSwapToSwapInfo(swap), swap.Status, swap.Memo)
+		} else {
+			c.addPending(isSwapin, ConvertMgoSwapToSwapInfo(swap), swap.Status)
+		}
+	}
+	return nil
+}
+
+// swap results are matched by sender or bind address
+func (c *addressSwapCollector) collectSwapResults(isSwapin bool, address string) error {
+	statuses := append(append([]mongodb.SwapStatus{}, resultPendingStatuses...), resultFailedStatuses...)
+	results, err := mongodb.FindAddressSwapResults(isSwapin, address, statuses, maxQueryLimit)
+	if err != nil {
+		return err
+	}
+	for _, res := range results {
+		if !c.markSeen(isSwapin, res.Key) {
+			continue
+		}
+		swapInfo := ConvertMgoSwapResultToSwapInfo(res)
+		switch {
+		case containsStatus(resultFailedStatuses, res.Status):
+			c.addFailed(isSwapin, swapInfo, res.Status, res.Memo)
+		case res.Status == mongodb.MatchTxEmpty:
+			// the stage before sending swap tx is recorded in the registered swap
+			swap, errf := mongodb.FindSwap(isSwapin, res.TxID, res.PairID, res.Bind)
+			switch {
+			case errf != nil:
+				c.addPending(isSwapin, swapInfo, mongodb.TxNotSwapped)
+			case containsStatus(registerFailedStatuses, swap.Status):
+				c.addFailed(isSwapin, swapInfo, swap.Status, swap.Memo)
+			default:
+				c.addPending(isSwapin, swapInfo, swap.Status)
+			}
+		default:
+			c.addPending(isSwapin, swapInfo, res.Status)
+		}
+	}
+	return nil
+}
+
+func sortAddressSwapInfos(swaps []*AddressSwapInfo) {
+	sort.SliceStable(swaps, func(i, j int) bool {
+		return swaps[i].InitTime > swaps[j].InitTime
+	})
+}
+
+func getAddressPairOverviews(address string) ([]*AddressPairOverview, error) {
+	pairs := make(map[string]*AddressPairOverview)
+	getPair := func(pairID string) *AddressPairOverview {
+		pairID = strings.ToLower(pairID)
+		pair, exist := pairs[pairID]
+		if !exist {
+			pair = &AddressPairOverview{
+				PairID:  pairID,
+				Swapin:  &AddressSwapTotals{TotalValue: "0", TotalSwapValue: "0"},
+				Swapout: &AddressSwapTotals{TotalValue: "0", TotalSwapValue: "0"},
+			}
+			pairs[pairID] = pair
+		}
+		return pair
+	}
+	for _, pairID := range tokens.GetAllPairIDs() {
+		getPair(pairID)
+	}
+	for _, isSwapin := range []bool{true, false} {
+		stats, err := mongodb.GetAddressSwapStatistics(isSwapin, address)
+		if err != nil {
+			return nil, err
+		}
+		for _, stat := range stats {
+			pair := getPair(stat.PairID)
+			totals := pair.Swapout
+			if isSwapin {
+				totals = pair.Swapin
+			}
+			totals.TotalCount = stat.TotalCount
+			totals.SucceedCount = stat.StableCount
+			totals.TotalValue = stat.StableValue
+			totals.TotalSwapValue = stat.StableSwapValue
+		}
+	}
+	result := make([]*AddressPairOverview, 0, len(pairs))
+	for _, pair := range pairs {
+		isBlacked, err := mongodb.QueryBlacklist(address, pair.PairID)
+		if err != nil {
+			return nil, err
+		}
+		pair.IsBlacklisted = isBlacked
+		result = append(result, pair)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].PairID < result[j].PairID
+	})
+	return result, nil
+}
+
+// GetAddressOverview get overview of the swaps and states of address across all token pairs
+func GetAddressOverview(address string) (*AddressOverview, error) {
+	log.Debug("[api] receive GetAddressOverview", "address", address)
+	if address == "" {
+		return nil, apierrors.InvalidArgument.New("empty address")
+	}
+	overview := &AddressOverview{Address: address}
+
+	registered, err := mongodb.FindRegisteredAddress(strings.ToLower(address))
+	if err != nil && err != mongodb.ErrItemNotFound {
+		return nil, err
+	}
+	overview.IsRegistered = registered != nil
+
+	if btc.BridgeInstance != nil {
+		p2sh, errf := mongodb.FindP2shAddress(address)
+		if errf != nil && errf != mongodb.ErrItemNotFound {
+			return nil, errf
+		}
+		if p2sh != nil {
+			overview.P2shAddress = p2sh.P2shAddress
+		}
+		bindAddress, errf := mongodb.FindP2shBindAddress(address)
+		if errf != nil && errf != mongodb.ErrItemNotFound {
+			return nil, errf
+		}
+		overview.P2shBindAddress = bindAddress
+	}
+
+	overview.Pairs, err = getAddressPairOverviews(address)
+	if err != nil {
+		return nil, err
+	}
+
+	collector := &addressSwapCollector{seen: make(map[string]struct{})}
+	for _, isSwapin := range []bool{true, false} {
+		if err = collector.collectSwaps(isSwapin, address); err != nil {
+			return nil, err
+		}
+		if err = collector.collectSwapResults(isSwapin, address); err != nil {
+			return nil, err
+		}
+	}
+	sortAddressSwapInfos(collector.pending)
+	sortAddressSwapInfos(collector.failed)
+	overview.PendingSwaps = collector.pending
+	overview.FailedSwaps = collector.failed
+	if overview.PendingSwaps == nil {
+		overview.PendingSwaps = []*AddressSwapInfo{}
+	}
+	if overview.FailedSwaps == nil {
+		overview.FailedSwaps = []*AddressSwapInfo{}
+	}
+	return overview, nil
+}
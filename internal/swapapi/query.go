@@ -0,0 +1,135 @@
+package swapapi
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anyswap/CrossChain-Bridge/common"
+	"github.com/anyswap/CrossChain-Bridge/log"
+	"github.com/anyswap/CrossChain-Bridge/mongodb"
+	"github.com/anyswap/CrossChain-Bridge/rpc/apierrors"
+)
+
+const (
+	defaultQueryLimit = 20
+	maxQueryLimit     = 100
+)
+
+// QuerySwaps query swaps with filters and cursor pagination
+func QuerySwaps(args *QuerySwapsArgs) (*QuerySwapsResult, error) {
+	log.Debug("[api] receive QuerySwaps", "args", args)
+	filter, err := getSwapResultFilter(args)
+	if err != nil {
+		return nil, apierrors.InvalidArgument.Wrap("wrong query args! ", err)
+	}
+	cursor, err := mongodb.DecodeSwapResultCursor(args.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	limit := args.Limit
+	switch {
+	case limit <= 0:
+		limit = defaultQueryLimit
+	case limit > maxQueryLimit:
+		limit = maxQueryLimit
+	}
+
+	var results []*mongodb.MgoSwapResult
+	switch strings.ToLower(args.SwapType) {
+	case "swapin":
+		results, err = mongodb.QuerySwapResults(true, filter, cursor, limit)
+	case "swapout":
+		results, err = mongodb.QuerySwapResults(false, filter, cursor, limit)
+	case "":
+		results, err = querySwapResultsOfBothDirections(filter, cursor, limit)
+	default:
+		return nil, apierrors.InvalidArgument.Errorf("wrong query args! unknown swap type '%v'", args.SwapType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := &QuerySwapsResult{
+		Swaps: ConvertMgoSwapResultsToSwapInfos(results),
+	}
+	if len(results) == limit {
+		last := results[len(results)-1]
+		result.NextCursor = (&mongodb.SwapResultCursor{InitTime: last.InitTime, Key: last.Key}).EncodeCursor()
+	}
+	return result, nil
+}
+
+func getSwapResultFilter(args *QuerySwapsArgs) (filter *mongodb.SwapResultFilter, err error) {
+	filter = &mongodb.SwapResultFilter{
+		PairID:       args.PairID,
+		Address:      args.Address,
+		Bind:         args.Bind,
+		Statuses:     convertSwapStatuses(args.Status),
+		InitTimeFrom: args.InitTimeFrom,
+		InitTimeTo:   args.InitTimeTo,
+		TxTimeFrom:   args.TxTimeFrom,
+		TxTimeTo:     args.TxTimeTo,
+		HasSwapTx:    args.HasSwapTx,
+	}
+	if args.MinValue != "" {
+		filter.MinValue, err = common.GetBigIntFromStr(args.MinValue)
+		if err != nil || !mongodb.IsValidQueryValue(filter.MinValue) {
+			return nil, fmt.Errorf("wrong min value '%v', must be non-negative integer of at most %v digits", args.MinValue, mongodb.MaxQueryValueDigits)
+		}
+	}
+	if args.MaxValue != "" {
+		filter.MaxValue, err = common.GetBigIntFromStr(args.MaxValue)
+		if err != nil || !mongodb.IsValidQueryValue(filter.MaxValue) {
+			return nil, fmt.Errorf("wrong max value '%v', must be non-negative integer of at most %v digits", args.MaxValue, mongodb.MaxQueryValueDigits)
+		}
+	}
+	switch strings.ToLower(args.Order) {
+	case "", "desc":
+	case "asc":
+		filter.Ascending = true
+	default:
+		return nil, fmt.Errorf("unknown order '%v'", args.Order)
+	}
+	return filter, nil
+}
+
+// merge the sorted pages of swapin and swapout results
+func querySwapResultsOfBothDirections(filter *mongodb.SwapResultFilter, cursor *mongodb.SwapResultCursor, limit int) ([]*mongodb.MgoSwapResult, error) {
+	swapins, err := mongodb.QuerySwapResults(true, filter, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+	swapouts, err := mongodb.QuerySwapResults(false, filter, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+	isBefore := func(a, b *mongodb.MgoSwapResult) bool {
+		if a.InitTime != b.InitTime {
+			return (a.InitTime < b.InitTime) == filter.Ascending
+		}
+		return (a.Key < b.Key) == filter.Ascending
+	}
+	results := make([]*mongodb.MgoSwapResult, 0, limit)
+	i, j := 0, 0
+	for len(results) < limit && (i < len(swapins) || j < len(swapouts)) {
+		if j == len(swapouts) || (i < len(swapins) && isBefore(swapins[i], swapouts[j])) {
+			results = append(results, swapins[i])
+			i++
+		} else {
+			results = append(results, swapouts[j])
+			j++
+		}
+	}
+	return results, nil
+}
+
+func convertSwapStatuses(statuses []SwapStatus) []mongodb.SwapStatus {
+	if len(statuses) == 0 {
+		return nil
+	}
+	result := make([]mongodb.SwapStatus, len(statuses))
+	for i, status := range statuses {
+		result[i] = mongodb.SwapStatus(status)
+	}
+	return result
+}
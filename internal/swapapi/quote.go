@@ -0,0 +1,49 @@
+package swapapi
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/anyswap/CrossChain-Bridge/common"
+	"github.com/anyswap/CrossChain-Bridge/log"
+	"github.com/anyswap/CrossChain-Bridge/rpc/apierrors"
+	"github.com/anyswap/CrossChain-Bridge/tokens"
+)
+
+// QuoteSwap quote the received value and fee of swapping amount (in smallest unit)
+func QuoteSwap(pairID, direction, amount string) (*QuoteSwapResult, error) {
+	log.Debug("[api] receive QuoteSwap", "pairID", pairID, "direction", direction, "amount", amount)
+	var isSwapin bool
+	switch strings.ToLower(direction) {
+	case "swapin":
+		isSwapin = true
+	case "swapout":
+		isSwapin = false
+	default:
+		return nil, apierrors.InvalidArgument.Errorf("wrong query args! unknown direction '%v'", direction)
+	}
+	value, err := common.GetBigIntFromStr(amount)
+	if err != nil || value.Sign() < 0 {
+		return nil, apierrors.InvalidArgument.Errorf("wrong query args! wrong amount '%v'", amount)
+	}
+	fromTokenCfg, _ := tokens.GetTokenConfigsByDirection(pairID, isSwapin)
+	if fromTokenCfg == nil {
+		return nil, errTokenPairNotExist
+	}
+	pairID = strings.ToLower(pairID)
+	swapValue := tokens.CalcSwappedValue(pairID, value, isSwapin)
+	result := &QuoteSwapResult{
+		PairID:      pairID,
+		SwapType:    strings.ToLower(direction),
+		Value:       value.String(),
+		SwapValue:   swapValue.String(),
+		SwapFee:     new(big.Int).Sub(value, swapValue).String(),
+		IsInRange:   tokens.CheckSwapValue(pairID, value, isSwapin),
+		IsBigValue:  value.Cmp(tokens.GetBigValueThreshold(pairID, isSwapin)) > 0,
+		DisableSwap: fromTokenCfg.IsSwapDisabled(),
+	}
+	if chainCfg := tokens.GetCrossChainBridge(isSwapin).GetChainConfig(); chainCfg.Confirmations != nil {
+		result.ExpectedConfirmations = *chainCfg.Confirmations
+	}
+	return result, nil
+}
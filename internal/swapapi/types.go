@@ -2,11 +2,12 @@ package swapapi
 
 import (
 	"github.com/anyswap/CrossChain-Bridge/mongodb"
+	"github.com/anyswap/CrossChain-Bridge/rpc/apitypes"
 	"github.com/anyswap/CrossChain-Bridge/tokens"
 )
 
 // SwapStatus type alias
-type SwapStatus = mongodb.SwapStatus
+type SwapStatus = apitypes.SwapStatus
 
 // Swap type alias
 type Swap = mongodb.MgoSwap
@@ -23,7 +24,7 @@ type LatestScanInfo = mongodb.MgoLatestScanInfo
 // RegisteredAddress type alias
 type RegisteredAddress = mongodb.MgoRegisteredAddress
 
-// ServerInfo server info
+// ServerInfo server info, its json encoding is the same as apitypes.ServerInfo
 type ServerInfo struct {
 	Identifier          string
 	MustRegisterAccount bool
@@ -31,35 +32,41 @@ type ServerInfo struct {
 	DestChain           *tokens.ChainConfig
 	PairIDs             []string
 	Version             string
+	MaintenanceWindows  []*tokens.MaintenanceWindow
 }
 
-// PostResult post result
-type PostResult string
+// PostResult type alias
+type PostResult = apitypes.PostResult
 
 // SuccessPostResult success post result
-var SuccessPostResult PostResult = "Success"
-
-// SwapInfo swap info
-type SwapInfo struct {
-	PairID        string     `json:"pairid"`
-	TxID          string     `json:"txid"`
-	TxTo          string     `json:"txto"`
-	TxHeight      uint64     `json:"txheight"`
-	TxTime        uint64     `json:"txtime"`
-	From          string     `json:"from"`
-	To            string     `json:"to"`
-	Bind          string     `json:"bind"`
-	Value         string     `json:"value"`
-	SwapTx        string     `json:"swaptx"`
-	SwapHeight    uint64     `json:"swapheight"`
-	SwapTime      uint64     `json:"swaptime"`
-	SwapValue     string     `json:"swapvalue"`
-	SwapType      uint32     `json:"swaptype"`
-	SwapNonce     uint64     `json:"swapnonce"`
-	Status        SwapStatus `json:"status"`
-	StatusMsg     string     `json:"statusmsg"`
-	InitTime      int64      `json:"inittime"`
-	Timestamp     int64      `json:"timestamp"`
-	Memo          string     `json:"memo"`
-	Confirmations uint64     `json:"confirmations"`
-}
+var SuccessPostResult = apitypes.SuccessPostResult
+
+// QueuedPostResult queued post result
+var QueuedPostResult = apitypes.QueuedPostResult
+
+// SwapInfo type alias
+type SwapInfo = apitypes.SwapInfo
+
+// QuerySwapsArgs type alias
+type QuerySwapsArgs = apitypes.QuerySwapsArgs
+
+// QuerySwapsResult type alias
+type QuerySwapsResult = apitypes.QuerySwapsResult
+
+// QuoteSwapResult type alias
+type QuoteSwapResult = apitypes.QuoteSwapResult
+
+// AddressOverview type alias
+type AddressOverview = apitypes.AddressOverview
+
+// AddressPairOverview type alias
+type AddressPairOverview = apitypes.AddressPairOverview
+
+// AddressSwapTotals type alias
+type AddressSwapTotals = apitypes.AddressSwapTotals
+
+// AddressSwapInfo type alias
+type AddressSwapInfo = apitypes.AddressSwapInfo
+
+// SwapNonceInfo type alias
+type SwapNonceInfo = apitypes.SwapNonceInfo
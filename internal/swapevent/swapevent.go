@@ -0,0 +1,75 @@
+// Package swapevent provides an in-process event bus of swap status changes.
+package swapevent
+
+import (
+	"sync"
+)
+
+const defaultBufferSize = 256
+
+// Event swap or swap result changed event
+type Event struct {
+	IsSwapin  bool
+	IsResult  bool
+	TxID      string
+	PairID    string
+	Bind      string
+	Status    uint16
+	StatusMsg string
+}
+
+// Subscription event subscription
+type Subscription struct {
+	C <-chan *Event
+
+	ch   chan *Event
+	once sync.Once
+}
+
+var (
+	subsLock    sync.RWMutex
+	subscribers = make(map[*Subscription]struct{})
+)
+
+// Subscribe subscribe swap events, buffer size defaults to 256 if not positive.
+// events are dropped if the subscriber is too slow to consume them.
+func Subscribe(bufferSize int) *Subscription {
+	if bufferSize <= 0 {
+		bufferSize = defaultBufferSize
+	}
+	ch := make(chan *Event, bufferSize)
+	sub := &Subscription{C: ch, ch: ch}
+	subsLock.Lock()
+	subscribers[sub] = struct{}{}
+	subsLock.Unlock()
+	return sub
+}
+
+// Unsubscribe unsubscribe and close the event channel
+func (sub *Subscription) Unsubscribe() {
+	sub.once.Do(func() {
+		subsLock.Lock()
+		delete(subscribers, sub)
+		subsLock.Unlock()
+		close(sub.ch)
+	})
+}
+
+// Publish publish swap event to all subscribers without blocking
+func Publish(ev *Event) {
+	subsLock.RLock()
+	defer subsLock.RUnlock()
+	for sub := range subscribers {
+		select {
+		case sub.ch <- ev:
+		default:
+		}
+	}
+}
+
+// HasSubscribers returns if there are any subscribers
+func HasSubscribers() bool {
+	subsLock.RLock()
+	defer subsLock.RUnlock()
+	return len(subscribers) > 0
+}
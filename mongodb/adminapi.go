@@ -9,6 +9,7 @@ import (
 	"github.com/anyswap/CrossChain-Bridge/log"
 	"github.com/anyswap/CrossChain-Bridge/tokens"
 	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
 )
 
 // --------------- blacklist --------------------------------
@@ -17,19 +18,46 @@ func getBlacklistKey(address, pairID string) string {
 	return strings.ToLower(address + ":" + pairID)
 }
 
+func initBlacklistEntry(mb *MgoBlackAccount) {
+	mb.Address = strings.ToLower(mb.Address)
+	mb.PairID = strings.ToLower(mb.PairID)
+	mb.Key = getBlacklistKey(mb.Address, mb.PairID)
+	mb.Timestamp = time.Now().Unix()
+}
+
+// exclude expired entries, entries without expire time never expire
+func getNotExpiredBlacklistQuery(now int64) bson.M {
+	return bson.M{"expiretime": bson.M{"$not": bson.M{"$gt": 0, "$lte": now}}}
+}
+
 // AddToBlacklist add to blacklist
-func AddToBlacklist(address, pairID string) error {
-	mb := &MgoBlackAccount{
-		Key:       getBlacklistKey(address, pairID),
-		Address:   strings.ToLower(address),
-		PairID:    strings.ToLower(pairID),
-		Timestamp: time.Now().Unix(),
-	}
+func AddToBlacklist(mb *MgoBlackAccount) error {
+	initBlacklistEntry(mb)
 	err := collBlacklist.Insert(mb)
 	if err == nil {
-		log.Info("mongodb add to black list success", "address", address, "pairID", pairID)
+		log.Info("mongodb add to black list success", "address", mb.Address, "pairID", mb.PairID, "reason", mb.Reason, "source", mb.Source, "expire", mb.ExpireTime)
 	} else {
-		log.Info("mongodb add to black list failed", "address", address, "pairID", pairID, "err", err)
+		log.Info("mongodb add to black list failed", "address", mb.Address, "pairID", mb.PairID, "err", err)
+	}
+	return mgoError(err)
+}
+
+// ImportBlacklist add or overwrite blacklist entries in bulk
+func ImportBlacklist(entries []*MgoBlackAccount) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	bulk := collBlacklist.Bulk()
+	bulk.Unordered()
+	for _, mb := range entries {
+		initBlacklistEntry(mb)
+		bulk.Upsert(bson.M{"_id": mb.Key}, mb)
+	}
+	_, err := bulk.Run()
+	if err == nil {
+		log.Info("mongodb import black list success", "count", len(entries))
+	} else {
+		log.Warn("mongodb import black list failed", "count", len(entries), "err", err)
 	}
 	return mgoError(err)
 }
@@ -45,17 +73,82 @@ func RemoveFromBlacklist(address, pairID string) error {
 	return mgoError(err)
 }
 
-// QueryBlacklist query if is blacked
+// ArchiveExpiredBlacklist move expired blacklist entries to blacklist history,
+// so that they are kept for audit and the same address can be blacked again.
+func ArchiveExpiredBlacklist() (archived int, err error) {
+	now := time.Now().Unix()
+	var expired []*MgoBlackAccount
+	err = collBlacklist.Find(bson.M{"expiretime": bson.M{"$gt": 0, "$lte": now}}).All(&expired)
+	if err != nil {
+		return 0, mgoError(err)
+	}
+	for _, mb := range expired {
+		history := &MgoBlackAccountHistory{
+			Key:         fmt.Sprintf("%v:%v", mb.Key, mb.ExpireTime),
+			Address:     mb.Address,
+			PairID:      mb.PairID,
+			Reason:      mb.Reason,
+			Source:      mb.Source,
+			ExpireTime:  mb.ExpireTime,
+			Timestamp:   mb.Timestamp,
+			ArchiveTime: now,
+		}
+		_, err = collBlacklistHistory.UpsertId(history.Key, history)
+		if err != nil {
+			log.Warn("mongodb archive expired black list failed", "key", mb.Key, "err", err)
+			return archived, mgoError(err)
+		}
+		// the entry may be overwritten by importing after we find it
+		err = collBlacklist.Remove(bson.M{"_id": mb.Key, "expiretime": mb.ExpireTime})
+		if err != nil && err != mgo.ErrNotFound {
+			log.Warn("mongodb remove archived black list failed", "key", mb.Key, "err", err)
+			return archived, mgoError(err)
+		}
+		archived++
+	}
+	if archived > 0 {
+		log.Info("mongodb archive expired black list success", "count", archived)
+	}
+	return archived, nil
+}
+
+// QueryBlacklist query if is blacked in pair or in all pairs
 func QueryBlacklist(address, pairID string) (isBlacked bool, err error) {
-	var result MgoBlackAccount
-	err = collBlacklist.FindId(getBlacklistKey(address, pairID)).One(&result)
-	if err == nil {
-		return true, nil
+	keys := []string{getBlacklistKey(address, allPairs)}
+	if !strings.EqualFold(pairID, allPairs) {
+		keys = append(keys, getBlacklistKey(address, pairID))
+	}
+	query := getNotExpiredBlacklistQuery(time.Now().Unix())
+	query["_id"] = bson.M{"$in": keys}
+	count, err := collBlacklist.Find(query).Count()
+	if err != nil {
+		return false, mgoError(err)
+	}
+	return count > 0, nil
+}
+
+// FindBlacklist find not expired blacklist entries blocking pair (including 'all' scope entries),
+// empty or 'all' pairID means entries of all pairs. limit 0 means no limit.
+// returns the total count of matched entries for pagination.
+func FindBlacklist(pairID string, offset, limit int) (total int, result []*MgoBlackAccount, err error) {
+	query := getNotExpiredBlacklistQuery(time.Now().Unix())
+	if pairID != "" && !strings.EqualFold(pairID, allPairs) {
+		query["pairid"] = bson.M{"$in": []string{strings.ToLower(pairID), allPairs}}
 	}
-	if err == mgo.ErrNotFound {
-		return false, nil
+	q := collBlacklist.Find(query)
+	total, err = q.Count()
+	if err != nil {
+		return 0, nil, mgoError(err)
+	}
+	q = q.Sort("pairid", "address").Skip(offset)
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+	err = q.All(&result)
+	if err != nil {
+		return 0, nil, mgoError(err)
 	}
-	return false, err
+	return total, result, nil
 }
 
 // PassSwapinBigValue pass swapin big value
@@ -178,13 +271,14 @@ func checkReswapNonce(bridge tokens.CrossChainBridge, res *MgoSwapResult, forceO
 	// eth enhanced, if we fail at nonce a, we should retry after nonce a
 	// to ensure tx with nonce a is on blockchain to prevent double swapping
 	var nonce uint64
+	signer := tokenCfg.GetSwapSigner(res.SwapFrom)
 	retryGetNonceCount := 3
 	for i := 0; i < retryGetNonceCount; i++ {
-		nonce, err = nonceSetter.GetPoolNonce(tokenCfg.DcrmAddress, "latest")
+		nonce, err = nonceSetter.GetPoolNonce(signer, "latest")
 		if err == nil {
 			break
 		}
-		log.Warn("get account nonce failed", "address", tokenCfg.DcrmAddress)
+		log.Warn("get account nonce failed", "address", signer)
 		time.Sleep(time.Second)
 	}
 	if nonce <= res.SwapNonce {
@@ -0,0 +1,90 @@
+package mongodb
+
+import (
+	"strings"
+	"time"
+
+	"github.com/anyswap/CrossChain-Bridge/log"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// admin call status values
+const (
+	AdminCallCalling = "calling"
+	AdminCallSuccess = "success"
+	AdminCallFailed  = "failed"
+)
+
+// AdminCallFilter filter of querying admin calls.
+// zero value fields are ignored.
+type AdminCallFilter struct {
+	Signer   string
+	Method   string
+	TimeFrom int64 // unix seconds, inclusive
+	TimeTo   int64 // unix seconds, exclusive
+}
+
+// AddAdminCall add admin call record before calling,
+// returns ErrItemIsDup if the admin tx is already called (replayed).
+func AddAdminCall(mc *MgoAdminCall) error {
+	mc.Signer = strings.ToLower(mc.Signer)
+	mc.Status = AdminCallCalling
+	mc.Timestamp = time.Now().Unix()
+	err := collAdminCalls.Insert(mc)
+	if err != nil {
+		log.Warn("mongodb add admin call failed", "txhash", mc.Key, "signer", mc.Signer, "method", mc.Method, "params", mc.Params, "err", err)
+	}
+	return mgoError(err)
+}
+
+// UpdateAdminCallResult update result of admin call
+func UpdateAdminCallResult(key, result string, callErr error) error {
+	updates := bson.M{
+		"status": AdminCallSuccess,
+		"result": result,
+	}
+	if callErr != nil {
+		updates["status"] = AdminCallFailed
+		updates["error"] = callErr.Error()
+	}
+	err := collAdminCalls.UpdateId(key, bson.M{"$set": updates})
+	if err != nil {
+		log.Warn("mongodb update admin call result failed", "txhash", key, "err", err)
+	}
+	return mgoError(err)
+}
+
+// FindAdminCalls find the latest admin calls with filter
+func FindAdminCalls(filter *AdminCallFilter, limit int) ([]*MgoAdminCall, error) {
+	var queries []bson.M
+	if filter.Signer != "" {
+		queries = append(queries, bson.M{"signer": strings.ToLower(filter.Signer)})
+	}
+	if filter.Method != "" {
+		queries = append(queries, bson.M{"method": filter.Method})
+	}
+	if filter.TimeFrom != 0 || filter.TimeTo != 0 {
+		queries = append(queries, bson.M{"timestamp": rangeQuery(filter.TimeFrom, filter.TimeTo)})
+	}
+	var query bson.M
+	switch len(queries) {
+	case 0:
+	case 1:
+		query = queries[0]
+	default:
+		query = bson.M{"$and": queries}
+	}
+	result := make([]*MgoAdminCall, 0, limit)
+	err := collAdminCalls.Find(query).Sort("-timestamp").Limit(limit).All(&result)
+	if err != nil {
+		return nil, mgoError(err)
+	}
+	return result, nil
+}
+
+// ensure compound indexes for querying admin calls
+func ensureAdminCallIndexes(collection *mgo.Collection) {
+	_ = collection.EnsureIndexKey("signer", "timestamp")
+	_ = collection.EnsureIndexKey("method", "timestamp")
+}
@@ -134,6 +134,7 @@ func addSwap(collection *mgo.Collection, ms *MgoSwap) error {
 	err := collection.Insert(ms)
 	if err == nil {
 		log.Info("mongodb add swap", "txid", ms.TxID, "pairID", ms.PairID, "bind", ms.Bind, "isSwapin", isSwapin(collection))
+		publishSwapEvent(collection, false, ms.TxID, ms.PairID, ms.Bind, ms.Status)
 	} else {
 		log.Debug("mongodb add swap", "txid", ms.TxID, "pairID", ms.PairID, "bind", ms.Bind, "isSwapin", isSwapin(collection), "err", err)
 	}
@@ -160,10 +161,11 @@ func updateSwapStatus(collection *mgo.Collection, txid, pairID, bind string, sta
 	if err == nil {
 		printLog := log.Info
 		switch status {
-		case TxVerifyFailed, TxSwapFailed:
+		case TxVerifyFailed, TxSwapFailed, SwapWithBadSignature:
 			printLog = log.Warn
 		}
 		printLog("mongodb update swap status", "txid", txid, "pairID", pairID, "bind", bind, "status", status, "isSwapin", isSwapin(collection))
+		publishSwapEvent(collection, false, txid, pairID, bind, status)
 	} else {
 		log.Debug("mongodb update swap status", "txid", txid, "pairID", pairID, "bind", bind, "status", status, "isSwapin", isSwapin(collection), "err", err)
 	}
@@ -335,10 +337,12 @@ func addSwapResult(collection *mgo.Collection, ms *MgoSwapResult) error {
 	}
 	ms.PairID = strings.ToLower(ms.PairID)
 	ms.Key = GetSwapKey(ms.TxID, ms.PairID, ms.Bind)
+	ms.NumValue = toDecimal128(ms.Value)
 	ms.InitTime = common.NowMilli()
 	err := collection.Insert(ms)
 	if err == nil {
 		log.Info("mongodb add swap result", "txid", ms.TxID, "pairID", ms.PairID, "bind", ms.Bind, "swaptype", ms.SwapType, "value", ms.Value, "isSwapin", isSwapin(collection))
+		publishSwapEvent(collection, true, ms.TxID, ms.PairID, ms.Bind, ms.Status)
 	} else {
 		log.Debug("mongodb add swap result", "txid", ms.TxID, "pairID", ms.PairID, "bind", ms.Bind, "swaptype", ms.SwapType, "value", ms.Value, "isSwapin", isSwapin(collection), "err", err)
 	}
@@ -374,6 +378,9 @@ func updateSwapResult(collection *mgo.Collection, txid, pairID, bind string, ite
 	if items.SwapNonce != 0 {
 		updates["swapnonce"] = items.SwapNonce
 	}
+	if items.SwapFrom != "" {
+		updates["swapfrom"] = items.SwapFrom
+	}
 	if items.Memo != "" {
 		updates["memo"] = items.Memo
 	} else if items.Status == MatchTxNotStable {
@@ -382,6 +389,9 @@ func updateSwapResult(collection *mgo.Collection, txid, pairID, bind string, ite
 	err := collection.UpdateId(GetSwapKey(txid, pairID, bind), bson.M{"$set": updates})
 	if err == nil {
 		log.Info("mongodb update swap result", "txid", txid, "pairID", pairID, "bind", bind, "updates", updates, "isSwapin", isSwapin(collection))
+		if items.Status != KeepStatus {
+			publishSwapEvent(collection, true, txid, pairID, bind, items.Status)
+		}
 	} else {
 		log.Debug("mongodb update swap result", "txid", txid, "pairID", pairID, "bind", bind, "updates", updates, "isSwapin", isSwapin(collection), "err", err)
 	}
@@ -404,6 +414,7 @@ func updateSwapResultStatus(collection *mgo.Collection, txid, pairID, bind strin
 	isSwapin := isSwapin(collection)
 	if err == nil {
 		log.Info("mongodb update swap result status", "txid", txid, "pairID", pairID, "bind", bind, "status", status, "isSwapin", isSwapin)
+		publishSwapEvent(collection, true, txid, pairID, bind, status)
 	} else {
 		log.Debug("mongodb update swap result status", "txid", txid, "pairID", pairID, "bind", bind, "status", status, "isSwapin", isSwapin, "err", err)
 	}
@@ -467,6 +478,25 @@ func findSwapResults(collection *mgo.Collection, address, pairID string, offset,
 	return result, nil
 }
 
+// HasUnstableSwapResultsFrom has swap results sent from address which are not stable,
+// statuses of swap results whose swap tx is being sent or replaced.
+func HasUnstableSwapResultsFrom(address string) (bool, error) {
+	query := bson.M{
+		"swapfrom": bson.M{"$in": []string{address, strings.ToLower(address)}},
+		"status":   bson.M{"$in": []SwapStatus{MatchTxEmpty, MatchTxNotStable}},
+	}
+	for _, collection := range []*mgo.Collection{collSwapinResult, collSwapoutResult} {
+		count, err := collection.Find(query).Limit(1).Count()
+		if err != nil {
+			return false, mgoError(err)
+		}
+		if count > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func getCount(collection *mgo.Collection, pairID string) (int, error) {
 	pairID = strings.ToLower(pairID)
 	return collection.Find(bson.M{"pairid": pairID}).Count()
@@ -0,0 +1,124 @@
+package mongodb
+
+import (
+	"strings"
+	"time"
+
+	"github.com/anyswap/CrossChain-Bridge/common"
+	"github.com/anyswap/CrossChain-Bridge/log"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// bulk job status values
+const (
+	BulkJobRunning  = "running"
+	BulkJobFinished = "finished"
+)
+
+// SwapFilter filter of querying swaps (registers).
+// zero value fields are ignored.
+type SwapFilter struct {
+	PairID       string
+	Bind         string
+	Statuses     []SwapStatus
+	InitTimeFrom int64 // milliseconds, inclusive
+	InitTimeTo   int64 // milliseconds, exclusive
+}
+
+// FindSwapsWithFilter find the earliest swaps matched by filter
+func FindSwapsWithFilter(isSwapin bool, filter *SwapFilter, limit int) ([]*MgoSwap, error) {
+	collection := collSwapout
+	if isSwapin {
+		collection = collSwapin
+	}
+	result := make([]*MgoSwap, 0, limit)
+	err := collection.Find(buildSwapQuery(filter)).Sort("inittime", "_id").Limit(limit).All(&result)
+	if err != nil {
+		return nil, mgoError(err)
+	}
+	return result, nil
+}
+
+// GetCountOfSwapsWithFilter get count of swaps matched by filter
+func GetCountOfSwapsWithFilter(isSwapin bool, filter *SwapFilter) (int, error) {
+	collection := collSwapout
+	if isSwapin {
+		collection = collSwapin
+	}
+	count, err := collection.Find(buildSwapQuery(filter)).Count()
+	return count, mgoError(err)
+}
+
+func buildSwapQuery(filter *SwapFilter) bson.M {
+	var queries []bson.M
+	if filter.PairID != "" && filter.PairID != allPairs {
+		queries = append(queries, bson.M{"pairid": strings.ToLower(filter.PairID)})
+	}
+	if filter.Bind != "" {
+		bind := filter.Bind
+		if common.IsHexAddress(bind) {
+			bind = strings.ToLower(bind)
+		}
+		queries = append(queries, bson.M{"bind": bind})
+	}
+	if len(filter.Statuses) != 0 {
+		queries = append(queries, bson.M{"status": bson.M{"$in": filter.Statuses}})
+	}
+	if filter.InitTimeFrom != 0 || filter.InitTimeTo != 0 {
+		queries = append(queries, bson.M{"inittime": rangeQuery(filter.InitTimeFrom, filter.InitTimeTo)})
+	}
+	switch len(queries) {
+	case 0:
+		return bson.M{}
+	case 1:
+		return queries[0]
+	default:
+		return bson.M{"$and": queries}
+	}
+}
+
+// AddBulkJob add running bulk job, the job key is generated if empty
+func AddBulkJob(job *MgoBulkJob) error {
+	if job.Key == "" {
+		job.Key = bson.NewObjectId().Hex()
+	}
+	now := time.Now().Unix()
+	job.Status = BulkJobRunning
+	job.CreateTime = now
+	job.Timestamp = now
+	err := collAdminBulkJobs.Insert(job)
+	if err == nil {
+		log.Info("mongodb add bulk job success", "id", job.Key, "method", job.Method, "params", job.Params, "total", job.Total)
+	} else {
+		log.Warn("mongodb add bulk job failed", "id", job.Key, "method", job.Method, "params", job.Params, "err", err)
+	}
+	return mgoError(err)
+}
+
+// UpdateBulkJobProgress update progress and status of bulk job
+func UpdateBulkJobProgress(job *MgoBulkJob) error {
+	job.Timestamp = time.Now().Unix()
+	updates := bson.M{
+		"status":    job.Status,
+		"processed": job.Processed,
+		"succeeded": job.Succeeded,
+		"failed":    job.Failed,
+		"errors":    job.Errors,
+		"timestamp": job.Timestamp,
+	}
+	err := collAdminBulkJobs.UpdateId(job.Key, bson.M{"$set": updates})
+	if err != nil {
+		log.Warn("mongodb update bulk job progress failed", "id", job.Key, "err", err)
+	}
+	return mgoError(err)
+}
+
+// FindBulkJob find bulk job
+func FindBulkJob(key string) (*MgoBulkJob, error) {
+	var result MgoBulkJob
+	err := collAdminBulkJobs.FindId(key).One(&result)
+	if err != nil {
+		return nil, mgoError(err)
+	}
+	return &result, nil
+}
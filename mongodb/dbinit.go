@@ -86,6 +86,19 @@ func sessionPing() (err error) {
 	return err
 }
 
+// Ping ping mongodb once (without retrying)
+func Ping() (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("recover from error %v", r)
+		}
+	}()
+	if session == nil {
+		return fmt.Errorf("mongodb session is not initialized")
+	}
+	return session.Ping()
+}
+
 func ensureMongoConnected() (err error) {
 	err = sessionPing()
 	if err != nil {
@@ -31,4 +31,5 @@ var (
 	ErrItemIsDup    = newError(-32003, "mgoError: Item is duplicate")
 	ErrSwapNotFound = newError(-32011, "mgoError: Swap is not found")
 	ErrWrongKey     = newError(-32012, "mgoError: Wrong key")
+	ErrWrongCursor  = newError(-32013, "mgoError: Wrong cursor")
 )
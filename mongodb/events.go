@@ -0,0 +1,18 @@
+package mongodb
+
+import (
+	"github.com/anyswap/CrossChain-Bridge/internal/swapevent"
+	"gopkg.in/mgo.v2"
+)
+
+func publishSwapEvent(collection *mgo.Collection, isResult bool, txid, pairID, bind string, status SwapStatus) {
+	swapevent.Publish(&swapevent.Event{
+		IsSwapin:  isSwapin(collection),
+		IsResult:  isResult,
+		TxID:      txid,
+		PairID:    pairID,
+		Bind:      bind,
+		Status:    uint16(status),
+		StatusMsg: status.String(),
+	})
+}
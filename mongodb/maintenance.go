@@ -0,0 +1,114 @@
+package mongodb
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/anyswap/CrossChain-Bridge/log"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// AddMaintenanceWindow add maintenance window, the key is generated if empty
+func AddMaintenanceWindow(mw *MgoMaintenanceWindow) error {
+	if mw.Key == "" {
+		mw.Key = bson.NewObjectId().Hex()
+	}
+	mw.PairID = strings.ToLower(mw.PairID)
+	mw.CreatedBy = strings.ToLower(mw.CreatedBy)
+	mw.Timestamp = time.Now().Unix()
+	err := collMaintenances.Insert(mw)
+	if err == nil {
+		log.Info("mongodb add maintenance window success", "id", mw.Key, "pairID", mw.PairID, "direction", mw.Direction, "start", mw.StartTime, "end", mw.EndTime, "reason", mw.Reason)
+	} else {
+		log.Warn("mongodb add maintenance window failed", "pairID", mw.PairID, "direction", mw.Direction, "err", err)
+	}
+	return mgoError(err)
+}
+
+// RemoveMaintenanceWindow remove maintenance window
+func RemoveMaintenanceWindow(key string) error {
+	err := collMaintenances.RemoveId(key)
+	if err == nil {
+		log.Info("mongodb remove maintenance window success", "id", key)
+	} else {
+		log.Warn("mongodb remove maintenance window failed", "id", key, "err", err)
+	}
+	return mgoError(err)
+}
+
+// FindMaintenanceWindows find maintenance windows not ended at timestamp
+func FindMaintenanceWindows(timestamp int64) ([]*MgoMaintenanceWindow, error) {
+	var result []*MgoMaintenanceWindow
+	err := collMaintenances.Find(bson.M{"endtime": bson.M{"$gt": timestamp}}).Sort("starttime").All(&result)
+	if err != nil {
+		return nil, mgoError(err)
+	}
+	return result, nil
+}
+
+func getQueuedRegisterKey(txid, pairID string, isSwapin bool) string {
+	return strings.ToLower(fmt.Sprintf("%v:%v:%v", txid, pairID, isSwapin))
+}
+
+// AddQueuedRegister queue swap register, returns ErrItemIsDup if already queued
+func AddQueuedRegister(txid, pairID string, isSwapin bool) error {
+	mq := &MgoQueuedRegister{
+		Key:       getQueuedRegisterKey(txid, pairID, isSwapin),
+		TxID:      txid,
+		PairID:    strings.ToLower(pairID),
+		IsSwapin:  isSwapin,
+		Timestamp: time.Now().Unix(),
+	}
+	err := collQueuedRegisters.Insert(mq)
+	if err == nil {
+		log.Info("mongodb queue swap register success", "txid", txid, "pairID", pairID, "isSwapin", isSwapin)
+	} else {
+		log.Info("mongodb queue swap register failed", "txid", txid, "pairID", pairID, "isSwapin", isSwapin, "err", err)
+	}
+	return mgoError(err)
+}
+
+// GetQueuedRegisterCount get number of queued swap registers of pairID and direction
+func GetQueuedRegisterCount(pairID string, isSwapin bool) (int, error) {
+	count, err := collQueuedRegisters.Find(bson.M{"pairid": strings.ToLower(pairID), "isswapin": isSwapin}).Count()
+	return count, mgoError(err)
+}
+
+// FindQueuedRegisters find the earliest queued swap registers,
+// excluding those of swapin pairIDs and swapout pairIDs (eg. still in maintenance)
+func FindQueuedRegisters(excludeSwapinPairIDs, excludeSwapoutPairIDs []string, limit int) ([]*MgoQueuedRegister, error) {
+	var excludes []bson.M
+	if len(excludeSwapinPairIDs) != 0 {
+		excludes = append(excludes, bson.M{"isswapin": true, "pairid": bson.M{"$in": excludeSwapinPairIDs}})
+	}
+	if len(excludeSwapoutPairIDs) != 0 {
+		excludes = append(excludes, bson.M{"isswapin": false, "pairid": bson.M{"$in": excludeSwapoutPairIDs}})
+	}
+	var query bson.M
+	if len(excludes) != 0 {
+		query = bson.M{"$nor": excludes}
+	}
+	result := make([]*MgoQueuedRegister, 0, limit)
+	err := collQueuedRegisters.Find(query).Sort("timestamp").Limit(limit).All(&result)
+	if err != nil {
+		return nil, mgoError(err)
+	}
+	return result, nil
+}
+
+// UpdateQueuedRegisterAttempt record failed attempt of registering queued swap
+func UpdateQueuedRegisterAttempt(key string, registerErr error) error {
+	updates := bson.M{
+		"$inc": bson.M{"attempts": 1},
+		"$set": bson.M{"lasterror": registerErr.Error()},
+	}
+	err := collQueuedRegisters.UpdateId(key, updates)
+	return mgoError(err)
+}
+
+// RemoveQueuedRegister remove queued swap register
+func RemoveQueuedRegister(key string) error {
+	err := collQueuedRegisters.RemoveId(key)
+	return mgoError(err)
+}
@@ -0,0 +1,174 @@
+package mongodb
+
+import (
+	"strings"
+
+	"github.com/anyswap/CrossChain-Bridge/common"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// AddressSwapStatistics swap statistics of an address in a token pair,
+// values are sums of the stable swaps.
+type AddressSwapStatistics struct {
+	PairID          string
+	TotalCount      int
+	StableCount     int
+	StableValue     string
+	StableSwapValue string
+}
+
+type addressSwapStatisticsItem struct {
+	PairID          string          `bson:"_id"`
+	TotalCount      int             `bson:"totalcount"`
+	StableCount     int             `bson:"stablecount"`
+	StableValue     bson.Decimal128 `bson:"stablevalue"`
+	StableSwapValue bson.Decimal128 `bson:"stableswapvalue"`
+}
+
+func getAddressForQuery(address string) string {
+	if common.IsHexAddress(address) {
+		return strings.ToLower(address)
+	}
+	return address
+}
+
+// swap results swapped from or bound to address
+func addressSwapResultQuery(address string) bson.M {
+	address = getAddressForQuery(address)
+	return bson.M{"$or": []bson.M{{"from": address}, {"bind": address}}}
+}
+
+func withStatuses(query bson.M, statuses []SwapStatus) bson.M {
+	if len(statuses) == 0 {
+		return query
+	}
+	return bson.M{"$and": []bson.M{query, {"status": bson.M{"$in": statuses}}}}
+}
+
+// GetAddressSwapStatistics get swap statistics of swap results swapped from or bound to address, grouped by pairID
+func GetAddressSwapStatistics(isSwapin bool, address string) ([]*AddressSwapStatistics, error) {
+	collection := collSwapoutResult
+	if isSwapin {
+		collection = collSwapinResult
+	}
+	// sum as decimal (requires MongoDB 4.0+), and use decimal zero to keep the sum type
+	zero, _ := bson.ParseDecimal128("0")
+	isStable := bson.M{"$eq": []interface{}{"$status", MatchTxStable}}
+	stableSum := func(field string) bson.M {
+		decimal := bson.M{"$convert": bson.M{"input": field, "to": "decimal", "onError": zero, "onNull": zero}}
+		return bson.M{"$sum": bson.M{"$cond": []interface{}{isStable, decimal, zero}}}
+	}
+	pipeline := []bson.M{
+		{"$match": addressSwapResultQuery(address)},
+		{"$group": bson.M{
+			"_id":             "$pairid",
+			"totalcount":      bson.M{"$sum": 1},
+			"stablecount":     bson.M{"$sum": bson.M{"$cond": []interface{}{isStable, 1, 0}}},
+			"stablevalue":     stableSum("$value"),
+			"stableswapvalue": stableSum("$swapvalue"),
+		}},
+		{"$sort": bson.M{"_id": 1}},
+	}
+	var items []*addressSwapStatisticsItem
+	err := collection.Pipe(pipeline).All(&items)
+	if err != nil {
+		return nil, mgoError(err)
+	}
+	result := make([]*AddressSwapStatistics, len(items))
+	for i, item := range items {
+		result[i] = &AddressSwapStatistics{
+			PairID:          item.PairID,
+			TotalCount:      item.TotalCount,
+			StableCount:     item.StableCount,
+			StableValue:     item.StableValue.String(),
+			StableSwapValue: item.StableSwapValue.String(),
+		}
+	}
+	return result, nil
+}
+
+// FindAddressSwapResults find the latest swap results swapped from or bound to address with statuses
+func FindAddressSwapResults(isSwapin bool, address string, statuses []SwapStatus, limit int) ([]*MgoSwapResult, error) {
+	collection := collSwapoutResult
+	if isSwapin {
+		collection = collSwapinResult
+	}
+	result := make([]*MgoSwapResult, 0, limit)
+	query := withStatuses(addressSwapResultQuery(address), statuses)
+	err := collection.Find(query).Sort("-inittime").Limit(limit).All(&result)
+	if err != nil {
+		return nil, mgoError(err)
+	}
+	return result, nil
+}
+
+// FindBindAddressSwaps find the latest registered swaps bound to address with statuses
+func FindBindAddressSwaps(isSwapin bool, bind string, statuses []SwapStatus, limit int) ([]*MgoSwap, error) {
+	collection := collSwapout
+	if isSwapin {
+		collection = collSwapin
+	}
+	result := make([]*MgoSwap, 0, limit)
+	query := withStatuses(bson.M{"bind": getAddressForQuery(bind)}, statuses)
+	err := collection.Find(query).Sort("-inittime").Limit(limit).All(&result)
+	if err != nil {
+		return nil, mgoError(err)
+	}
+	return result, nil
+}
+
+// ensure indexes for querying swaps by bind address
+func ensureSwapIndexes(collection *mgo.Collection) {
+	_ = collection.EnsureIndexKey("bind", "inittime")
+}
+
+// SwapStatusCount number of swaps of a token pair in status
+type SwapStatusCount struct {
+	PairID string
+	Status SwapStatus
+	Count  int
+}
+
+type swapStatusCountItem struct {
+	ID struct {
+		PairID string     `bson:"pairid"`
+		Status SwapStatus `bson:"status"`
+	} `bson:"_id"`
+	Count int `bson:"count"`
+}
+
+// GetSwapStatusCounts get number of swaps (or swap results) per pair and status
+func GetSwapStatusCounts(isSwapin, isResult bool) ([]*SwapStatusCount, error) {
+	var collection *mgo.Collection
+	switch {
+	case isSwapin && isResult:
+		collection = collSwapinResult
+	case isSwapin:
+		collection = collSwapin
+	case isResult:
+		collection = collSwapoutResult
+	default:
+		collection = collSwapout
+	}
+	pipeline := []bson.M{
+		{"$group": bson.M{
+			"_id":   bson.M{"pairid": "$pairid", "status": "$status"},
+			"count": bson.M{"$sum": 1},
+		}},
+	}
+	var items []*swapStatusCountItem
+	err := collection.Pipe(pipeline).All(&items)
+	if err != nil {
+		return nil, mgoError(err)
+	}
+	result := make([]*SwapStatusCount, len(items))
+	for i, item := range items {
+		result[i] = &SwapStatusCount{
+			PairID: item.ID.PairID,
+			Status: item.ID.Status,
+			Count:  item.Count,
+		}
+	}
+	return result, nil
+}
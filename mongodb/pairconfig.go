@@ -0,0 +1,25 @@
+package mongodb
+
+import (
+	"strings"
+	"time"
+
+	"github.com/anyswap/CrossChain-Bridge/log"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// AddPairConfigChange add change record of token pair config
+func AddPairConfigChange(change *MgoPairConfigChange) error {
+	if change.Key == "" {
+		change.Key = bson.NewObjectId().Hex()
+	}
+	change.PairID = strings.ToLower(change.PairID)
+	change.Timestamp = time.Now().Unix()
+	err := collPairConfigChanges.Insert(change)
+	if err == nil {
+		log.Info("mongodb add pair config change success", "pairID", change.PairID, "operation", change.Operation, "changedBy", change.ChangedBy)
+	} else {
+		log.Warn("mongodb add pair config change failed", "pairID", change.PairID, "operation", change.Operation, "err", err)
+	}
+	return mgoError(err)
+}
@@ -0,0 +1,36 @@
+package mongodb
+
+import (
+	"strings"
+	"time"
+
+	"github.com/anyswap/CrossChain-Bridge/log"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// UpdatePairState set swap state of deposit or withdraw direction of token pair
+func UpdatePairState(pairID string, isDeposit bool, state *MgoSwapState) error {
+	pairID = strings.ToLower(pairID)
+	field := "withdraw"
+	if isDeposit {
+		field = "deposit"
+	}
+	state.Timestamp = time.Now().Unix()
+	_, err := collPairStates.UpsertId(pairID, bson.M{"$set": bson.M{field: state}})
+	if err == nil {
+		log.Info("mongodb update pair state success", "pairID", pairID, "direction", field, "disable", state.DisableSwap, "reason", state.Reason, "setby", state.SetBy)
+	} else {
+		log.Warn("mongodb update pair state failed", "pairID", pairID, "direction", field, "err", err)
+	}
+	return mgoError(err)
+}
+
+// FindPairStates find all token pair states
+func FindPairStates() ([]*MgoPairState, error) {
+	var result []*MgoPairState
+	err := collPairStates.Find(nil).All(&result)
+	if err != nil {
+		return nil, mgoError(err)
+	}
+	return result, nil
+}
@@ -0,0 +1,39 @@
+package mongodb
+
+import (
+	"strings"
+	"time"
+
+	"github.com/anyswap/CrossChain-Bridge/log"
+)
+
+// only one manual payout is allowed for the original tx of a pair
+func getManualPayoutKey(txid, pairID string) string {
+	return strings.ToLower(txid + ":" + pairID)
+}
+
+// AddManualPayout add manual payout,
+// returns ErrItemIsDup if the original tx is already paid out.
+func AddManualPayout(mp *MgoManualPayout) error {
+	mp.PairID = strings.ToLower(mp.PairID)
+	mp.Key = getManualPayoutKey(mp.TxID, mp.PairID)
+	mp.CreatedBy = strings.ToLower(mp.CreatedBy)
+	mp.Timestamp = time.Now().Unix()
+	err := collManualPayouts.Insert(mp)
+	if err == nil {
+		log.Info("mongodb add manual payout success", "txid", mp.TxID, "pairID", mp.PairID, "bind", mp.Bind, "value", mp.Value, "swapType", mp.SwapType, "createdBy", mp.CreatedBy)
+	} else {
+		log.Warn("mongodb add manual payout failed", "txid", mp.TxID, "pairID", mp.PairID, "bind", mp.Bind, "err", err)
+	}
+	return mgoError(err)
+}
+
+// FindManualPayout find manual payout
+func FindManualPayout(txid, pairID string) (*MgoManualPayout, error) {
+	result := &MgoManualPayout{}
+	err := collManualPayouts.FindId(getManualPayoutKey(txid, pairID)).One(result)
+	if err != nil {
+		return nil, mgoError(err)
+	}
+	return result, nil
+}
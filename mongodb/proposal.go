@@ -0,0 +1,113 @@
+package mongodb
+
+import (
+	"time"
+
+	"github.com/anyswap/CrossChain-Bridge/log"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// admin proposal status values
+const (
+	ProposalPending   = "pending"
+	ProposalExecuting = "executing"
+	ProposalExecuted  = "executed"
+	ProposalFailed    = "failed"
+	ProposalCancelled = "cancelled"
+	ProposalExpired   = "expired"
+)
+
+// AddAdminProposal add admin proposal
+func AddAdminProposal(mp *MgoAdminProposal) error {
+	now := time.Now().Unix()
+	mp.Status = ProposalPending
+	mp.CreateTime = now
+	mp.Timestamp = now
+	err := collAdminProposals.Insert(mp)
+	if err == nil {
+		log.Info("mongodb add admin proposal success", "id", mp.Key, "method", mp.Method, "params", mp.Params, "proposer", mp.Proposer)
+	} else {
+		log.Info("mongodb add admin proposal failed", "id", mp.Key, "method", mp.Method, "params", mp.Params, "proposer", mp.Proposer, "err", err)
+	}
+	return mgoError(err)
+}
+
+// FindAdminProposal find admin proposal
+func FindAdminProposal(key string) (*MgoAdminProposal, error) {
+	var result MgoAdminProposal
+	err := collAdminProposals.FindId(key).One(&result)
+	if err != nil {
+		return nil, mgoError(err)
+	}
+	return &result, nil
+}
+
+// FindAdminProposals find the latest admin proposals with status (empty status matches all)
+func FindAdminProposals(status string, limit int) ([]*MgoAdminProposal, error) {
+	var query bson.M
+	if status != "" {
+		query = bson.M{"status": status}
+	}
+	result := make([]*MgoAdminProposal, 0, limit)
+	err := collAdminProposals.Find(query).Sort("-createtime").Limit(limit).All(&result)
+	if err != nil {
+		return nil, mgoError(err)
+	}
+	return result, nil
+}
+
+// AddAdminProposalApprover add approver and its signed approve tx to pending admin proposal,
+// returns the updated proposal to count approvals atomically.
+func AddAdminProposalApprover(key, approver, approveTx string) (*MgoAdminProposal, error) {
+	selector := bson.M{"_id": key, "status": ProposalPending, "approvers": bson.M{"$ne": approver}}
+	change := mgo.Change{
+		Update: bson.M{
+			"$push": bson.M{"approvers": approver, "approvetxs": approveTx},
+			"$set":  bson.M{"timestamp": time.Now().Unix()},
+		},
+		ReturnNew: true,
+	}
+	var result MgoAdminProposal
+	_, err := collAdminProposals.Find(selector).Apply(change, &result)
+	if err == nil {
+		log.Info("mongodb approve admin proposal success", "id", key, "approver", approver, "approvals", len(result.Approvers))
+	} else {
+		log.Info("mongodb approve admin proposal failed", "id", key, "approver", approver, "err", err)
+		return nil, mgoError(err)
+	}
+	return &result, nil
+}
+
+// ClaimAdminProposal change status of pending admin proposal to executing,
+// only one caller can claim it, others get ErrItemNotFound.
+func ClaimAdminProposal(key string) error {
+	return updateAdminProposalStatus(key, ProposalPending, ProposalExecuting, "")
+}
+
+// FinishAdminProposal update status of executing admin proposal
+func FinishAdminProposal(key, status, result string) error {
+	return updateAdminProposalStatus(key, ProposalExecuting, status, result)
+}
+
+// UpdateAdminProposalStatus update status of pending admin proposal,
+// returns ErrItemNotFound if the proposal is not pending.
+func UpdateAdminProposalStatus(key, status, result string) error {
+	return updateAdminProposalStatus(key, ProposalPending, status, result)
+}
+
+func updateAdminProposalStatus(key, fromStatus, status, result string) error {
+	selector := bson.M{"_id": key, "status": fromStatus}
+	updates := bson.M{"$set": bson.M{
+		"status":    status,
+		"result":    result,
+		"timestamp": time.Now().Unix(),
+	}}
+	err := collAdminProposals.Update(selector, updates)
+	if err == nil {
+		log.Info("mongodb update admin proposal status success", "id", key, "status", status, "result", result)
+	} else {
+		log.Info("mongodb update admin proposal status failed", "id", key, "status", status, "result", result, "err", err)
+	}
+	return mgoError(err)
+}
@@ -0,0 +1,223 @@
+package mongodb
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/anyswap/CrossChain-Bridge/common"
+	"github.com/anyswap/CrossChain-Bridge/log"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// SwapResultFilter filter of querying swap results.
+// zero value fields are ignored.
+type SwapResultFilter struct {
+	PairID       string
+	Address      string // swap from address
+	Bind         string
+	Statuses     []SwapStatus
+	InitTimeFrom int64 // milliseconds, inclusive
+	InitTimeTo   int64 // milliseconds, exclusive
+	TxTimeFrom   uint64
+	TxTimeTo     uint64
+	MinValue     *big.Int // inclusive
+	MaxValue     *big.Int // inclusive
+	HasSwapTx    *bool
+	Ascending    bool
+}
+
+// SwapResultCursor position of the last returned swap result
+type SwapResultCursor struct {
+	InitTime int64  `json:"t"`
+	Key      string `json:"k"`
+}
+
+// EncodeCursor encode cursor to an opaque string
+func (c *SwapResultCursor) EncodeCursor() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeSwapResultCursor decode cursor from string, returns nil if cursor is empty
+func DecodeSwapResultCursor(cursor string) (*SwapResultCursor, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrWrongCursor
+	}
+	var c SwapResultCursor
+	if err = json.Unmarshal(data, &c); err != nil || c.Key == "" {
+		return nil, ErrWrongCursor
+	}
+	return &c, nil
+}
+
+// QuerySwapResults query swap results with filter, sorted by (inittime, key),
+// and paginated by cursor which is the last item of the previous page.
+func QuerySwapResults(isSwapin bool, filter *SwapResultFilter, cursor *SwapResultCursor, limit int) ([]*MgoSwapResult, error) {
+	collection := collSwapoutResult
+	if isSwapin {
+		collection = collSwapinResult
+	}
+	result := make([]*MgoSwapResult, 0, limit)
+	q := collection.Find(buildSwapResultQuery(filter, cursor))
+	if filter.Ascending {
+		q = q.Sort("inittime", "_id")
+	} else {
+		q = q.Sort("-inittime", "-_id")
+	}
+	err := q.Limit(limit).All(&result)
+	if err != nil {
+		return nil, mgoError(err)
+	}
+	return result, nil
+}
+
+// nolint:gocyclo // allow big simple filter
+func buildSwapResultQuery(filter *SwapResultFilter, cursor *SwapResultCursor) bson.M {
+	var queries []bson.M
+
+	if filter.PairID != "" && filter.PairID != allPairs {
+		queries = append(queries, bson.M{"pairid": strings.ToLower(filter.PairID)})
+	}
+	if filter.Address != "" && filter.Address != allAddresses {
+		address := filter.Address
+		if common.IsHexAddress(address) {
+			address = strings.ToLower(address)
+		}
+		queries = append(queries, bson.M{"from": address})
+	}
+	if filter.Bind != "" {
+		bind := filter.Bind
+		if common.IsHexAddress(bind) {
+			bind = strings.ToLower(bind)
+		}
+		queries = append(queries, bson.M{"bind": bind})
+	}
+	if len(filter.Statuses) != 0 {
+		queries = append(queries, bson.M{"status": bson.M{"$in": filter.Statuses}})
+	}
+	if filter.InitTimeFrom != 0 || filter.InitTimeTo != 0 {
+		queries = append(queries, bson.M{"inittime": rangeQuery(filter.InitTimeFrom, filter.InitTimeTo)})
+	}
+	if filter.TxTimeFrom != 0 || filter.TxTimeTo != 0 {
+		queries = append(queries, bson.M{"txtime": rangeQuery(int64(filter.TxTimeFrom), int64(filter.TxTimeTo))})
+	}
+	// value is stored as decimal string, compare its numeric copy
+	if filter.MinValue != nil {
+		queries = append(queries, valueQuery("$gte", filter.MinValue))
+	}
+	if filter.MaxValue != nil {
+		queries = append(queries, valueQuery("$lte", filter.MaxValue))
+	}
+	if filter.HasSwapTx != nil {
+		if *filter.HasSwapTx {
+			queries = append(queries, bson.M{"swaptx": bson.M{"$nin": []interface{}{"", nil}}})
+		} else {
+			queries = append(queries, bson.M{"swaptx": bson.M{"$in": []interface{}{"", nil}}})
+		}
+	}
+	if cursor != nil {
+		cmp := "$lt"
+		if filter.Ascending {
+			cmp = "$gt"
+		}
+		queries = append(queries, bson.M{"$or": []bson.M{
+			{"inittime": bson.M{cmp: cursor.InitTime}},
+			{"inittime": cursor.InitTime, "_id": bson.M{cmp: cursor.Key}},
+		}})
+	}
+
+	switch len(queries) {
+	case 0:
+		return bson.M{}
+	case 1:
+		return queries[0]
+	default:
+		return bson.M{"$and": queries}
+	}
+}
+
+func rangeQuery(from, to int64) bson.M {
+	query := bson.M{}
+	if from != 0 {
+		query["$gte"] = from
+	}
+	if to != 0 {
+		query["$lt"] = to
+	}
+	return query
+}
+
+// MaxQueryValueDigits max digits of value range bound, which is the precision of decimal128
+const MaxQueryValueDigits = 34
+
+// IsValidQueryValue is value a valid range bound which can be compared exactly
+func IsValidQueryValue(value *big.Int) bool {
+	return value.Sign() >= 0 && len(value.String()) <= MaxQueryValueDigits
+}
+
+// value must be checked by IsValidQueryValue, as it is compared exactly
+func valueQuery(cmp string, value *big.Int) bson.M {
+	return bson.M{"numvalue": bson.M{cmp: toDecimal128(value.String())}}
+}
+
+// convert integer string to decimal128, digits exceeding the precision of
+// decimal128 (34 significant digits) are truncated
+func toDecimal128(value string) bson.Decimal128 {
+	if len(value) > MaxQueryValueDigits && strings.Trim(value, "0123456789") == "" {
+		value = fmt.Sprintf("%vE%v", value[:MaxQueryValueDigits], len(value)-MaxQueryValueDigits)
+	}
+	decimal, err := bson.ParseDecimal128(value)
+	if err != nil {
+		log.Warn("convert value to decimal128 failed", "value", value, "err", err)
+	}
+	return decimal
+}
+
+// set numeric copy of value for swap results added before it is introduced
+func fillSwapResultNumValues(collection *mgo.Collection) {
+	var item struct {
+		Key   string `bson:"_id"`
+		Value string `bson:"value"`
+	}
+	count := 0
+	iter := collection.Find(bson.M{"numvalue": bson.M{"$exists": false}}).Select(bson.M{"value": 1}).Iter()
+	for iter.Next(&item) {
+		err := collection.UpdateId(item.Key, bson.M{"$set": bson.M{"numvalue": toDecimal128(item.Value)}})
+		if err != nil {
+			log.Warn("fill swap result numvalue failed", "key", item.Key, "err", err)
+			continue
+		}
+		count++
+	}
+	if err := iter.Close(); err != nil {
+		log.Warn("fill swap result numvalue failed", "collection", collection.Name, "err", err)
+	}
+	if count > 0 {
+		log.Info("fill swap result numvalue success", "collection", collection.Name, "count", count)
+	}
+}
+
+// ensure compound indexes for querying swap results
+func ensureSwapResultIndexes(collection *mgo.Collection) {
+	indexes := [][]string{
+		{"inittime", "_id"},
+		{"pairid", "inittime", "_id"},
+		{"status", "inittime", "_id"},
+		{"from", "inittime", "_id"},
+		{"bind", "inittime", "_id"},
+		{"txtime"},
+		{"numvalue"},
+		{"swapfrom", "status"},
+	}
+	for _, key := range indexes {
+		_ = collection.EnsureIndexKey(key...)
+	}
+}
@@ -23,6 +23,7 @@ import (
 //                |- TxWithBigValue        ---> TxNotSwapped
 //                |- TxSenderNotRegistered ---> TxNotStable
 //                |- TxNotSwapped -> |- TxSwapFailed -> manual
+//                                   |- SwapWithBadSignature -> manual
 //                                   |- TxProcessed (->MatchTxNotStable)
 // -----------------------------------------------
 // 2. swap result status change graph
@@ -58,6 +59,7 @@ const (
 	ManualMakeFail                          // 16
 	BindAddrIsContract                      // 17
 	RPCQueryError                           // 18
+	SwapWithBadSignature                    // 19
 
 	KeepStatus = 255
 )
@@ -104,7 +106,18 @@ func (status SwapStatus) CanReverify() bool {
 		ManualMakeFail,
 		TxIncompatible,
 		BindAddrIsContract,
-		RPCQueryError:
+		RPCQueryError,
+		SwapWithBadSignature:
+		return true
+	default:
+		return false
+	}
+}
+
+// CanManualPayout can manual payout
+func (status SwapStatus) CanManualPayout() bool {
+	switch status {
+	case TxWithWrongMemo, TxWithWrongValue, BindAddrIsContract:
 		return true
 	default:
 		return false
@@ -162,6 +175,8 @@ func (status SwapStatus) String() string {
 		return "BindAddrIsContract"
 	case RPCQueryError:
 		return "RPCQueryError"
+	case SwapWithBadSignature:
+		return "SwapWithBadSignature"
 	default:
 		return fmt.Sprintf("unknown swap status %d", status)
 	}
@@ -14,7 +14,16 @@ var (
 	collLatestScanInfo    *mgo.Collection
 	collRegisteredAddress *mgo.Collection
 	collBlacklist         *mgo.Collection
+	collBlacklistHistory  *mgo.Collection
 	collLatestSwapNonces  *mgo.Collection
+	collAdminProposals    *mgo.Collection
+	collAdminCalls        *mgo.Collection
+	collPairStates        *mgo.Collection
+	collAdminBulkJobs     *mgo.Collection
+	collMaintenances      *mgo.Collection
+	collQueuedRegisters   *mgo.Collection
+	collPairConfigChanges *mgo.Collection
+	collManualPayouts     *mgo.Collection
 )
 
 func isSwapin(collection *mgo.Collection) bool {
@@ -32,7 +41,16 @@ func deinintCollections() {
 	collLatestScanInfo = database.C(tbLatestScanInfo)
 	collRegisteredAddress = database.C(tbRegisteredAddress)
 	collBlacklist = database.C(tbBlacklist)
+	collBlacklistHistory = database.C(tbBlacklistHistory)
 	collLatestSwapNonces = database.C(tbLatestSwapNonces)
+	collAdminProposals = database.C(tbAdminProposals)
+	collAdminCalls = database.C(tbAdminCalls)
+	collPairStates = database.C(tbPairStates)
+	collAdminBulkJobs = database.C(tbAdminBulkJobs)
+	collMaintenances = database.C(tbMaintenances)
+	collQueuedRegisters = database.C(tbQueuedRegisters)
+	collPairConfigChanges = database.C(tbPairConfigChanges)
+	collManualPayouts = database.C(tbManualPayouts)
 }
 
 func initCollections() {
@@ -44,8 +62,26 @@ func initCollections() {
 	initCollection(tbSwapStatistics, &collSwapStatistics)
 	initCollection(tbLatestScanInfo, &collLatestScanInfo)
 	initCollection(tbRegisteredAddress, &collRegisteredAddress)
-	initCollection(tbBlacklist, &collBlacklist)
+	initCollection(tbBlacklist, &collBlacklist, "pairid", "expiretime")
+	initCollection(tbBlacklistHistory, &collBlacklistHistory, "address", "archivetime")
 	initCollection(tbLatestSwapNonces, &collLatestSwapNonces, "address")
+	initCollection(tbAdminProposals, &collAdminProposals, "status", "createtime")
+	initCollection(tbAdminCalls, &collAdminCalls, "timestamp")
+	initCollection(tbPairStates, &collPairStates)
+	initCollection(tbAdminBulkJobs, &collAdminBulkJobs, "createtime")
+	initCollection(tbMaintenances, &collMaintenances, "endtime")
+	initCollection(tbQueuedRegisters, &collQueuedRegisters, "timestamp")
+	initCollection(tbPairConfigChanges, &collPairConfigChanges, "pairid", "timestamp")
+	initCollection(tbManualPayouts, &collManualPayouts, "timestamp")
+
+	ensureSwapResultIndexes(collSwapinResult)
+	ensureSwapResultIndexes(collSwapoutResult)
+	ensureSwapIndexes(collSwapin)
+	ensureSwapIndexes(collSwapout)
+	ensureAdminCallIndexes(collAdminCalls)
+
+	go fillSwapResultNumValues(collSwapinResult)
+	go fillSwapResultNumValues(collSwapoutResult)
 
 	initDefaultValue()
 }
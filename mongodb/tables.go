@@ -1,5 +1,7 @@
 package mongodb
 
+import "gopkg.in/mgo.v2/bson"
+
 const (
 	tbSwapins           string = "Swapins"
 	tbSwapouts          string = "Swapouts"
@@ -10,7 +12,16 @@ const (
 	tbLatestScanInfo    string = "LatestScanInfo"
 	tbRegisteredAddress string = "RegisteredAddress"
 	tbBlacklist         string = "Blacklist"
+	tbBlacklistHistory  string = "BlacklistHistory"
 	tbLatestSwapNonces  string = "LatestSwapNonces"
+	tbAdminProposals    string = "AdminProposals"
+	tbAdminCalls        string = "AdminCalls"
+	tbPairStates        string = "PairStates"
+	tbAdminBulkJobs     string = "AdminBulkJobs"
+	tbMaintenances      string = "MaintenanceWindows"
+	tbQueuedRegisters   string = "QueuedRegisters"
+	tbPairConfigChanges string = "PairConfigChanges"
+	tbManualPayouts     string = "ManualPayouts"
 
 	keyOfSrcLatestScanInfo string = "srclatest"
 	keyOfDstLatestScanInfo string = "dstlatest"
@@ -32,27 +43,29 @@ type MgoSwap struct {
 
 // MgoSwapResult swap result (verified swap)
 type MgoSwapResult struct {
-	Key        string     `bson:"_id"` // txid + pairid + bind
-	PairID     string     `bson:"pairid"`
-	TxID       string     `bson:"txid"`
-	TxTo       string     `bson:"txto"`
-	TxHeight   uint64     `bson:"txheight"`
-	TxTime     uint64     `bson:"txtime"`
-	From       string     `bson:"from"`
-	To         string     `bson:"to"`
-	Bind       string     `bson:"bind"`
-	Value      string     `bson:"value"`
-	SwapTx     string     `bson:"swaptx"`
-	OldSwapTxs []string   `bson:"oldswaptxs"`
-	SwapHeight uint64     `bson:"swapheight"`
-	SwapTime   uint64     `bson:"swaptime"`
-	SwapValue  string     `bson:"swapvalue"`
-	SwapType   uint32     `bson:"swaptype"`
-	SwapNonce  uint64     `bson:"swapnonce"`
-	Status     SwapStatus `bson:"status"`
-	InitTime   int64      `bson:"inittime"`
-	Timestamp  int64      `bson:"timestamp"`
-	Memo       string     `bson:"memo"`
+	Key        string          `bson:"_id"` // txid + pairid + bind
+	PairID     string          `bson:"pairid"`
+	TxID       string          `bson:"txid"`
+	TxTo       string          `bson:"txto"`
+	TxHeight   uint64          `bson:"txheight"`
+	TxTime     uint64          `bson:"txtime"`
+	From       string          `bson:"from"`
+	To         string          `bson:"to"`
+	Bind       string          `bson:"bind"`
+	Value      string          `bson:"value"`
+	NumValue   bson.Decimal128 `bson:"numvalue" json:"-"` // numeric copy of value for range query
+	SwapTx     string          `bson:"swaptx"`
+	OldSwapTxs []string        `bson:"oldswaptxs"`
+	SwapHeight uint64          `bson:"swapheight"`
+	SwapTime   uint64          `bson:"swaptime"`
+	SwapValue  string          `bson:"swapvalue"`
+	SwapType   uint32          `bson:"swaptype"`
+	SwapNonce  uint64          `bson:"swapnonce"`
+	SwapFrom   string          `bson:"swapfrom"`
+	Status     SwapStatus      `bson:"status"`
+	InitTime   int64           `bson:"inittime"`
+	Timestamp  int64           `bson:"timestamp"`
+	Memo       string          `bson:"memo"`
 }
 
 // SwapResultUpdateItems swap update items
@@ -64,6 +77,7 @@ type SwapResultUpdateItems struct {
 	SwapValue  string
 	SwapType   uint32
 	SwapNonce  uint64
+	SwapFrom   string
 	Status     SwapStatus
 	Timestamp  int64
 	Memo       string
@@ -102,10 +116,25 @@ type MgoLatestScanInfo struct {
 
 // MgoBlackAccount key is address
 type MgoBlackAccount struct {
-	Key       string `bson:"_id"` // address + pairid
-	Address   string `bson:"address"`
-	PairID    string `bson:"pairid"`
-	Timestamp int64  `bson:"timestamp"`
+	Key        string `bson:"_id"` // address + pairid
+	Address    string `bson:"address"`
+	PairID     string `bson:"pairid"` // 'all' means all pairs
+	Reason     string `bson:"reason"`
+	Source     string `bson:"source"`
+	ExpireTime int64  `bson:"expiretime"` // unix seconds, 0 means never expire
+	Timestamp  int64  `bson:"timestamp"`
+}
+
+// MgoBlackAccountHistory expired blacklist entry archived for audit
+type MgoBlackAccountHistory struct {
+	Key         string `bson:"_id"` // address + pairid + expiretime
+	Address     string `bson:"address"`
+	PairID      string `bson:"pairid"`
+	Reason      string `bson:"reason"`
+	Source      string `bson:"source"`
+	ExpireTime  int64  `bson:"expiretime"`
+	Timestamp   int64  `bson:"timestamp"`
+	ArchiveTime int64  `bson:"archivetime"`
 }
 
 // MgoLatestSwapNonce latest swap nonce
@@ -116,3 +145,113 @@ type MgoLatestSwapNonce struct {
 	SwapNonce uint64 `bson:"swapnonce"`
 	Timestamp int64  `bson:"timestamp"`
 }
+
+// MgoAdminProposal admin call proposal waiting for approvals of other admins
+type MgoAdminProposal struct {
+	Key        string   `bson:"_id"` // hash of the proposing admin tx
+	Method     string   `bson:"method"`
+	Params     []string `bson:"params"`
+	Proposer   string   `bson:"proposer"`
+	Approvers  []string `bson:"approvers"` // include proposer
+	Threshold  int      `bson:"threshold"`
+	Status     string   `bson:"status"`
+	Result     string   `bson:"result"`
+	CreateTime int64    `bson:"createtime"`
+	ExpireTime int64    `bson:"expiretime"`
+	Timestamp  int64    `bson:"timestamp"`
+	RawTx      string   `bson:"rawtx"`      // admin signed tx of proposer
+	ApproveTxs []string `bson:"approvetxs"` // admin signed approve txs of approvers
+}
+
+// MgoAdminCall admin call record (audit log), key is the hash of admin tx to reject replays
+type MgoAdminCall struct {
+	Key       string   `bson:"_id"`
+	Signer    string   `bson:"signer"`
+	Method    string   `bson:"method"`
+	Params    []string `bson:"params"`
+	Status    string   `bson:"status"`
+	Result    string   `bson:"result"`
+	Error     string   `bson:"error"`
+	CallTime  int64    `bson:"calltime"` // timestamp in admin tx
+	Timestamp int64    `bson:"timestamp"`
+}
+
+// MgoPairState runtime swap state of token pair set by admin
+type MgoPairState struct {
+	Key      string        `bson:"_id"` // pairid
+	Deposit  *MgoSwapState `bson:"deposit,omitempty"`
+	Withdraw *MgoSwapState `bson:"withdraw,omitempty"`
+}
+
+// MgoSwapState swap state of one direction
+type MgoSwapState struct {
+	DisableSwap bool   `bson:"disableswap"`
+	Reason      string `bson:"reason"`
+	SetBy       string `bson:"setby"`
+	Timestamp   int64  `bson:"timestamp"`
+}
+
+// MgoBulkJob bulk admin job processing swaps matched by filter
+type MgoBulkJob struct {
+	Key        string   `bson:"_id"`
+	Method     string   `bson:"method"`
+	Params     []string `bson:"params"`
+	Caller     string   `bson:"caller"`
+	Status     string   `bson:"status"`
+	Total      int      `bson:"total"`
+	Processed  int      `bson:"processed"`
+	Succeeded  int      `bson:"succeeded"`
+	Failed     int      `bson:"failed"`
+	Errors     []string `bson:"errors"`
+	CreateTime int64    `bson:"createtime"`
+	Timestamp  int64    `bson:"timestamp"`
+}
+
+// MgoMaintenanceWindow scheduled maintenance window of token pair
+type MgoMaintenanceWindow struct {
+	Key       string `bson:"_id"`
+	PairID    string `bson:"pairid"`
+	Direction string `bson:"direction"` // deposit, withdraw or both
+	StartTime int64  `bson:"starttime"`
+	EndTime   int64  `bson:"endtime"`
+	Reason    string `bson:"reason"`
+	CreatedBy string `bson:"createdby"`
+	Timestamp int64  `bson:"timestamp"`
+}
+
+// MgoQueuedRegister swap register received during maintenance window
+type MgoQueuedRegister struct {
+	Key       string `bson:"_id"` // txid + pairid + swaptype
+	TxID      string `bson:"txid"`
+	PairID    string `bson:"pairid"`
+	IsSwapin  bool   `bson:"isswapin"`
+	Attempts  int    `bson:"attempts"`
+	LastError string `bson:"lasterror"`
+	Timestamp int64  `bson:"timestamp"`
+}
+
+// MgoPairConfigChange change record of token pair config
+type MgoPairConfigChange struct {
+	Key        string `bson:"_id"`
+	PairID     string `bson:"pairid"`
+	Operation  string `bson:"operation"` // add, update or remove
+	ConfigFile string `bson:"configfile"`
+	ChangedBy  string `bson:"changedby"`
+	OldConfig  string `bson:"oldconfig"` // json encoded
+	NewConfig  string `bson:"newconfig"` // json encoded
+	Timestamp  int64  `bson:"timestamp"`
+}
+
+// MgoManualPayout manual payout created by admin
+type MgoManualPayout struct {
+	Key        string   `bson:"_id"` // txid + pairid
+	TxID       string   `bson:"txid"`
+	PairID     string   `bson:"pairid"`
+	Bind       string   `bson:"bind"`
+	Value      string   `bson:"value"`
+	SwapType   uint32   `bson:"swaptype"`
+	AdminTx    string   `bson:"admintx"`    // admin signed call verified by oracles
+	ApproveTxs []string `bson:"approvetxs"` // admin signed approve txs if payout is proposed
+	CreatedBy  string   `bson:"createdby"`
+	Timestamp  int64    `bson:"timestamp"`
+}
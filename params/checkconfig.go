@@ -2,9 +2,12 @@ package params
 
 import (
 	"errors"
+	"fmt"
 	"math/big"
+	"strings"
 	"time"
 
+	"github.com/anyswap/CrossChain-Bridge/common"
 	"github.com/anyswap/CrossChain-Bridge/log"
 	"github.com/anyswap/CrossChain-Bridge/rpc/client"
 )
@@ -26,6 +29,22 @@ func CheckConfig(isServer bool) (err error) {
 		if config.APIServer == nil {
 			return errors.New("server must config 'APIServer'")
 		}
+		err = config.APIServer.CheckConfig()
+		if err != nil {
+			return err
+		}
+		for name, role := range config.AdminRoles {
+			err = role.CheckConfig(name)
+			if err != nil {
+				return err
+			}
+		}
+		if config.AdminApproval != nil {
+			err = config.AdminApproval.CheckConfig(getAdminMembersCount(config))
+			if err != nil {
+				return err
+			}
+		}
 	} else if config.SrcChain.EnableScan || config.DestChain.EnableScan {
 		if config.Oracle == nil {
 			return errors.New("oracle must config 'Oracle'")
@@ -148,6 +167,98 @@ func (c *OracleConfig) CheckConfig() (err error) {
 	return err
 }
 
+// CheckConfig api server config
+func (c *APIServerConfig) CheckConfig() (err error) {
+	if c.GRPCPort != 0 && c.GRPCPort == GetAPIPort() {
+		return fmt.Errorf("grpc port %v conflicts with api server port", c.GRPCPort)
+	}
+	if err = c.IPRateLimit.CheckConfig(); err != nil {
+		return fmt.Errorf("wrong 'IPRateLimit' in api server config, %v", err)
+	}
+	if err = c.APIKeyRateLimit.CheckConfig(); err != nil {
+		return fmt.Errorf("wrong 'APIKeyRateLimit' in api server config, %v", err)
+	}
+	for method, limit := range c.MethodRateLimits {
+		if err = limit.CheckConfig(); err != nil {
+			return fmt.Errorf("wrong rate limit of method '%v' in api server config, %v", method, err)
+		}
+	}
+	keys := make(map[string]struct{}, len(c.APIKeys))
+	for _, apiKey := range c.APIKeys {
+		if apiKey.Key == "" {
+			return fmt.Errorf("empty api key of '%v' in api server config", apiKey.Name)
+		}
+		if _, exist := keys[apiKey.Key]; exist {
+			return fmt.Errorf("duplicate api key of '%v' in api server config", apiKey.Name)
+		}
+		keys[apiKey.Key] = struct{}{}
+		if err = apiKey.RateLimit.CheckConfig(); err != nil {
+			return fmt.Errorf("wrong rate limit of api key '%v' in api server config, %v", apiKey.Name, err)
+		}
+	}
+	if c.RequireAPIKeyForWrite && len(c.APIKeys) == 0 {
+		return errors.New("'RequireAPIKeyForWrite' is set but no 'APIKeys' in api server config")
+	}
+	return nil
+}
+
+// CheckConfig rate limit config
+func (c *RateLimitConfig) CheckConfig() error {
+	if c == nil {
+		return nil
+	}
+	if c.Rate < 0 {
+		return errors.New("negative 'Rate'")
+	}
+	if c.Rate > 0 && c.Burst < 1 {
+		return errors.New("'Burst' must be at least 1")
+	}
+	return nil
+}
+
+// CheckConfig admin role config
+func (c *AdminRole) CheckConfig(name string) error {
+	if name == "" {
+		return errors.New("empty admin role name")
+	}
+	if len(c.Members) == 0 {
+		return fmt.Errorf("admin role '%v' has no 'Members'", name)
+	}
+	for _, member := range c.Members {
+		if !common.IsHexAddress(member) {
+			return fmt.Errorf("wrong member '%v' of admin role '%v'", member, name)
+		}
+	}
+	if len(c.Methods) == 0 {
+		return fmt.Errorf("admin role '%v' has no 'Methods'", name)
+	}
+	for _, method := range c.Methods {
+		if method == "" {
+			return fmt.Errorf("empty method of admin role '%v'", name)
+		}
+	}
+	return nil
+}
+
+// CheckConfig admin approval config
+func (c *AdminApprovalConfig) CheckConfig(adminCount int) error {
+	if c.ProposalLifetime < 0 {
+		return errors.New("negative 'ProposalLifetime' in admin approval config")
+	}
+	for method, threshold := range c.Thresholds {
+		if method == "" || strings.HasPrefix(method, AdminProposalsMethod) {
+			return fmt.Errorf("wrong admin method '%v' in admin approval config", method)
+		}
+		if threshold < 1 || threshold > adminCount {
+			return fmt.Errorf("wrong threshold %v of admin method '%v' in admin approval config, must be between 1 and number of admin members %v", threshold, method, adminCount)
+		}
+		if strings.Split(method, ":")[0] == AdminPayoutMethod && threshold < MinPayoutApprovalThreshold {
+			return fmt.Errorf("wrong threshold %v of admin method '%v' in admin approval config, must be at least %v", threshold, method, MinPayoutApprovalThreshold)
+		}
+	}
+	return nil
+}
+
 // CheckConfig extra config
 func (c *ExtraConfig) CheckConfig() (err error) {
 	if c.MinReserveFee != "" {
@@ -157,5 +268,8 @@ func (c *ExtraConfig) CheckConfig() (err error) {
 		}
 		log.Printf("MinReserveFee is %v", bi)
 	}
+	if c.SwapBatchSize < 0 {
+		return errors.New("wrong 'SwapBatchSize' in extra config")
+	}
 	return nil
 }
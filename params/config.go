@@ -14,6 +14,18 @@ import (
 const (
 	defaultAPIPort      = 11556
 	defServerConfigFile = "config.toml"
+
+	defaultAdminProposalLifetime = 86400
+
+	// AdminProposalsMethod admin method to list, approve and cancel proposals
+	AdminProposalsMethod = "proposals"
+
+	// AdminPayoutMethod admin method to create manual payout,
+	// which is also verified by oracles when signing the payout tx
+	AdminPayoutMethod = "payout"
+
+	// MinPayoutApprovalThreshold manual payout must be approved by at least so many admins
+	MinPayoutApprovalThreshold = 2
 )
 
 var (
@@ -39,6 +51,26 @@ type ServerConfig struct {
 	BtcExtra            *tokens.BtcExtraConfig `toml:",omitempty" json:",omitempty"`
 	Extra               *ExtraConfig           `toml:",omitempty" json:",omitempty"`
 	Admins              []string               `toml:",omitempty" json:",omitempty"`
+	AdminRoles          map[string]*AdminRole  `toml:",omitempty" json:",omitempty"` // key is role name
+	AdminApproval       *AdminApprovalConfig   `toml:",omitempty" json:",omitempty"`
+}
+
+// AdminRole admin role config, members of role can only call the admin methods of role
+type AdminRole struct {
+	Members []string
+	// admin method (eg. 'reverify') or method with operation (eg. 'blacklist:query')
+	Methods []string
+	// pairIDs the methods can operate on, empty means all pairs
+	PairIDs []string `toml:",omitempty" json:",omitempty"`
+}
+
+// AdminApprovalConfig m-of-n admin approval config
+type AdminApprovalConfig struct {
+	// key is admin method (eg. 'reswap') or method with operation (eg. 'manual:passswapin'),
+	// value is the number of admins (including the proposer) must approve before calling.
+	Thresholds map[string]int
+	// lifetime of proposal in seconds (default 86400)
+	ProposalLifetime int64 `toml:",omitempty" json:",omitempty"`
 }
 
 // DcrmConfig dcrm related config
@@ -64,12 +96,37 @@ type DcrmNodeConfig struct {
 // OracleConfig oracle config
 type OracleConfig struct {
 	ServerAPIAddress string
+	MetricsPort      int `toml:",omitempty" json:",omitempty"`
 }
 
 // APIServerConfig api service config
 type APIServerConfig struct {
 	Port           int
 	AllowedOrigins []string
+	GRPCPort       int `toml:",omitempty" json:",omitempty"` // 0 means grpc server is disabled
+
+	// use client ip in 'X-Forwarded-For' or 'X-Real-IP' header (behind reverse proxy)
+	TrustProxyHeaders bool `toml:",omitempty" json:",omitempty"`
+	// require api key (in 'X-API-Key' header) to call write methods
+	RequireAPIKeyForWrite bool `toml:",omitempty" json:",omitempty"`
+
+	APIKeys          []*APIKeyConfig             `toml:",omitempty" json:"-"`
+	IPRateLimit      *RateLimitConfig            `toml:",omitempty" json:",omitempty"`
+	APIKeyRateLimit  *RateLimitConfig            `toml:",omitempty" json:",omitempty"`
+	MethodRateLimits map[string]*RateLimitConfig `toml:",omitempty" json:",omitempty"` // key is rpc method or rest path template
+}
+
+// APIKeyConfig api key config
+type APIKeyConfig struct {
+	Name      string
+	Key       string
+	RateLimit *RateLimitConfig `toml:",omitempty" json:",omitempty"` // override 'APIKeyRateLimit'
+}
+
+// RateLimitConfig token bucket rate limit config
+type RateLimitConfig struct {
+	Rate  float64 // requests per second, 0 means no limit
+	Burst int     // max requests in a burst
 }
 
 // MongoDBConfig mongodb config
@@ -83,6 +140,7 @@ type MongoDBConfig struct {
 // ExtraConfig extra config
 type ExtraConfig struct {
 	MinReserveFee string
+	SwapBatchSize int `toml:",omitempty" json:",omitempty"`
 }
 
 // GetAPIPort get api service port
@@ -139,6 +197,15 @@ func GetExtraConfig() *ExtraConfig {
 	return GetConfig().Extra
 }
 
+// GetSwapBatchSize get max number of swaps signed in one dcrm sign round
+func GetSwapBatchSize() int {
+	extra := GetExtraConfig()
+	if extra == nil || extra.SwapBatchSize < 1 {
+		return 1
+	}
+	return extra.SwapBatchSize
+}
+
 // LoadConfig load config
 func LoadConfig(configFile string, isServer bool) *ServerConfig {
 	loadConfigStarter.Do(func() {
@@ -176,15 +243,113 @@ func LoadConfig(configFile string, isServer bool) *ServerConfig {
 
 // HasAdmin has admin
 func HasAdmin() bool {
-	return len(serverConfig.Admins) != 0
+	return len(serverConfig.Admins) != 0 || len(serverConfig.AdminRoles) != 0
 }
 
-// IsAdmin is admin
+// IsAdmin is admin (who can call all admin methods)
 func IsAdmin(account string) bool {
-	for _, admin := range serverConfig.Admins {
-		if strings.EqualFold(account, admin) {
+	return containsFold(serverConfig.Admins, account)
+}
+
+// IsAdminMember is admin or member of any admin role
+func IsAdminMember(account string) bool {
+	if IsAdmin(account) {
+		return true
+	}
+	for _, role := range serverConfig.AdminRoles {
+		if containsFold(role.Members, account) {
 			return true
 		}
 	}
 	return false
 }
+
+// GetAdminMembersCount get count of admins and distinct members of admin roles
+func GetAdminMembersCount() int {
+	return getAdminMembersCount(serverConfig)
+}
+
+func getAdminMembersCount(config *ServerConfig) int {
+	members := make(map[string]struct{})
+	for _, admin := range config.Admins {
+		members[strings.ToLower(admin)] = struct{}{}
+	}
+	for _, role := range config.AdminRoles {
+		for _, member := range role.Members {
+			members[strings.ToLower(member)] = struct{}{}
+		}
+	}
+	return len(members)
+}
+
+// IsAdminMethodAllowed is account allowed to call admin method with operation on pairIDs,
+// pairIDs is empty if the method is not pair specific, and pairID 'all' means all pairs.
+func IsAdminMethodAllowed(account, method, operation string, pairIDs []string) bool {
+	if IsAdmin(account) {
+		return true
+	}
+	for _, role := range serverConfig.AdminRoles {
+		if containsFold(role.Members, account) && role.isAllowed(method, operation, pairIDs) {
+			return true
+		}
+	}
+	return false
+}
+
+func (role *AdminRole) isAllowed(method, operation string, pairIDs []string) bool {
+	if !containsFold(role.Methods, method) && !containsFold(role.Methods, method+":"+operation) {
+		return false
+	}
+	if len(role.PairIDs) == 0 {
+		return true
+	}
+	for _, pairID := range pairIDs {
+		if strings.EqualFold(pairID, "all") || !containsFold(role.PairIDs, pairID) {
+			return false
+		}
+	}
+	return true
+}
+
+func containsFold(slice []string, s string) bool {
+	for _, item := range slice {
+		if strings.EqualFold(item, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetAdminApprovalThreshold get number of admins must approve admin call,
+// the threshold of method with operation takes precedence over that of method.
+// manual payout requires at least MinPayoutApprovalThreshold approvals.
+func GetAdminApprovalThreshold(method, operation string) int {
+	threshold := getAdminApprovalThreshold(method, operation)
+	if method == AdminPayoutMethod && threshold < MinPayoutApprovalThreshold {
+		return MinPayoutApprovalThreshold
+	}
+	return threshold
+}
+
+func getAdminApprovalThreshold(method, operation string) int {
+	approval := serverConfig.AdminApproval
+	if approval == nil {
+		return 1
+	}
+	if threshold, exist := approval.Thresholds[method+":"+operation]; exist && operation != "" {
+		return threshold
+	}
+	if threshold, exist := approval.Thresholds[method]; exist {
+		return threshold
+	}
+	return 1
+}
+
+// GetAdminProposalLifetime get lifetime of admin proposal in seconds
+func GetAdminProposalLifetime() int64 {
+	approval := serverConfig.AdminApproval
+	if approval == nil || approval.ProposalLifetime == 0 {
+		return defaultAdminProposalLifetime
+	}
+	return approval.ProposalLifetime
+}
@@ -0,0 +1,94 @@
+package params
+
+import (
+	"testing"
+)
+
+const (
+	testAdmin      = "0x1111111111111111111111111111111111111111"
+	testOperator   = "0x2222222222222222222222222222222222222222"
+	testAuditor    = "0x3333333333333333333333333333333333333333"
+	testNonAdmin   = "0x4444444444444444444444444444444444444444"
+	testOperatorUp = "0X2222222222222222222222222222222222222222"
+)
+
+func TestAdminRoleIsAllowed(t *testing.T) {
+	role := &AdminRole{
+		Methods: []string{"reverify", "blacklist:query", "Maintain"},
+		PairIDs: []string{"ETH", "fsn"},
+	}
+	allPairsRole := &AdminRole{
+		Methods: []string{"reswap"},
+	}
+	tests := []struct {
+		role      *AdminRole
+		method    string
+		operation string
+		pairIDs   []string
+		allowed   bool
+	}{
+		{role, "reverify", "swapin", []string{"eth"}, true},
+		{role, "reverify", "swapout", []string{"eth", "FSN"}, true},
+		{role, "maintain", "add", []string{"fsn"}, true},
+		{role, "blacklist", "query", []string{"eth"}, true},
+		{role, "blacklist", "add", []string{"eth"}, false},
+		{role, "reswap", "swapin", []string{"eth"}, false},
+		{role, "reverify", "swapin", []string{"btc"}, false},
+		{role, "reverify", "swapin", []string{"eth", "btc"}, false},
+		{role, "reverify", "swapin", []string{"all"}, false},
+		{role, "reverify", "swapin", nil, true}, // not pair specific
+		{allPairsRole, "reswap", "swapin", []string{"all"}, true},
+		{allPairsRole, "reswap", "swapin", []string{"btc"}, true},
+		{allPairsRole, "reverify", "swapin", []string{"btc"}, false},
+	}
+	for i, test := range tests {
+		allowed := test.role.isAllowed(test.method, test.operation, test.pairIDs)
+		if allowed != test.allowed {
+			t.Errorf("test %d: isAllowed(%v, %v, %v) = %v, want %v", i, test.method, test.operation, test.pairIDs, allowed, test.allowed)
+		}
+	}
+}
+
+func TestIsAdminMethodAllowed(t *testing.T) {
+	oldConfig := serverConfig
+	defer SetConfig(oldConfig)
+	SetConfig(&ServerConfig{
+		Admins: []string{testAdmin},
+		AdminRoles: map[string]*AdminRole{
+			"operator": {
+				Members: []string{testOperator},
+				Methods: []string{"reverify", "manual:passswapin"},
+				PairIDs: []string{"eth"},
+			},
+			"auditor": {
+				Members: []string{testOperator, testAuditor},
+				Methods: []string{"audit"},
+			},
+		},
+	})
+	tests := []struct {
+		account   string
+		method    string
+		operation string
+		pairIDs   []string
+		allowed   bool
+	}{
+		{testAdmin, "reswap", "swapin", []string{"all"}, true},
+		{testOperator, "reverify", "swapin", []string{"eth"}, true},
+		{testOperatorUp, "reverify", "swapin", []string{"eth"}, true},
+		{testOperator, "reverify", "swapin", []string{"all"}, false},
+		{testOperator, "manual", "passswapin", []string{"eth"}, true},
+		{testOperator, "manual", "failswapin", []string{"eth"}, false},
+		{testOperator, "audit", "", nil, true},
+		{testAuditor, "audit", "", nil, true},
+		{testAuditor, "reverify", "swapin", []string{"eth"}, false},
+		{testNonAdmin, "audit", "", nil, false},
+		{testNonAdmin, "reverify", "swapin", []string{"eth"}, false},
+	}
+	for i, test := range tests {
+		allowed := IsAdminMethodAllowed(test.account, test.method, test.operation, test.pairIDs)
+		if allowed != test.allowed {
+			t.Errorf("test %d: IsAdminMethodAllowed(%v, %v, %v, %v) = %v, want %v", i, test.account, test.method, test.operation, test.pairIDs, allowed, test.allowed)
+		}
+	}
+}
@@ -0,0 +1,219 @@
+package apidoc
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/anyswap/CrossChain-Bridge/params"
+	"github.com/anyswap/CrossChain-Bridge/rpc/apierrors"
+	"github.com/anyswap/CrossChain-Bridge/rpc/apitypes"
+)
+
+var (
+	openAPISpec     map[string]interface{}
+	openAPISpecOnce sync.Once
+
+	pathParamDescriptions = map[string]string{
+		"pairid":    "token pair id",
+		"txid":      "transaction hash",
+		"bind":      "bind address",
+		"address":   "address",
+		"direction": "swapin or swapout",
+		"amount":    "amount in the smallest unit",
+	}
+)
+
+type queryParam struct {
+	Name        string
+	Type        string
+	Description string
+}
+
+type restOperation struct {
+	Methods     []string
+	Path        string
+	Summary     string
+	QueryParams []*queryParam
+	Result      interface{}
+	ContentType string // default is application/json
+	IsHealth    bool   // returns 503 if unhealthy
+}
+
+var (
+	bindQueryParams = []*queryParam{
+		{"bind", "string", "bind address, required if the tx has multiple bind addresses"},
+	}
+	historyQueryParams = []*queryParam{
+		{"offset", "integer", "offset of items, negative means from the last"},
+		{"limit", "integer", "max number of items"},
+	}
+	subscribeQueryParams = []*queryParam{
+		{"txid", "string", "transaction hash, comma separated or repeated for multiple values"},
+		{"bind", "string", "bind address, comma separated or repeated for multiple values"},
+		{"pairid", "string", "token pair id, comma separated or repeated for multiple values"},
+	}
+	querySwapsParams = []*queryParam{
+		{"swaptype", "string", "swapin, swapout, or empty for both"},
+		{"pairid", "string", "token pair id"},
+		{"address", "string", "swap from address"},
+		{"bind", "string", "bind address"},
+		{"status", "string", "comma separated swap status"},
+		{"inittimefrom", "integer", "init time in milliseconds, inclusive"},
+		{"inittimeto", "integer", "init time in milliseconds, exclusive"},
+		{"txtimefrom", "integer", "tx time in seconds, inclusive"},
+		{"txtimeto", "integer", "tx time in seconds, exclusive"},
+		{"minvalue", "string", "min value in the smallest unit, inclusive"},
+		{"maxvalue", "string", "max value in the smallest unit, inclusive"},
+		{"hasswaptx", "boolean", "whether has swap tx"},
+		{"order", "string", "desc (default) or asc by init time"},
+		{"cursor", "string", "next cursor returned by the previous page"},
+		{"limit", "integer", "max number of items (default 20, max 100)"},
+	}
+)
+
+func getRestOperations() []*restOperation {
+	get := []string{"get"}
+	post := []string{"post"}
+	getAndPost := []string{"get", "post"}
+	return []*restOperation{
+		{Methods: get, Path: "/serverinfo", Summary: "get server info", Result: &apitypes.ServerInfo{}},
+		{Methods: get, Path: "/versioninfo", Summary: "get version info", Result: ""},
+		{Methods: get, Path: "/health/live", Summary: "liveness check", Result: &apitypes.HealthResult{}, IsHealth: true},
+		{Methods: get, Path: "/health/ready", Summary: "readiness check", Result: &apitypes.HealthResult{}, IsHealth: true},
+		{Methods: get, Path: "/pairinfo/{pairid}", Summary: "get token pair info", Result: &apitypes.TokenPairInfo{}},
+		{Methods: get, Path: "/statistics/{pairid}", Summary: "get swap statistics", Result: &apitypes.SwapStatistics{}},
+		{Methods: post, Path: "/swapin/post/{pairid}/{txid}", Summary: "post swapin", Result: apitypes.PostResult("")},
+		{Methods: post, Path: "/swapout/post/{pairid}/{txid}", Summary: "post swapout", Result: apitypes.PostResult("")},
+		{Methods: post, Path: "/swapin/p2sh/{txid}/{bind}", Summary: "post p2sh swapin", Result: apitypes.PostResult("")},
+		{Methods: post, Path: "/swapin/retry/{pairid}/{txid}", Summary: "retry swapin", Result: apitypes.PostResult("")},
+		{Methods: get, Path: "/swapin/{pairid}/{txid}", Summary: "get swapin", QueryParams: bindQueryParams, Result: &apitypes.SwapInfo{}},
+		{Methods: get, Path: "/swapout/{pairid}/{txid}", Summary: "get swapout", QueryParams: bindQueryParams, Result: &apitypes.SwapInfo{}},
+		{Methods: get, Path: "/swapin/{pairid}/{txid}/raw", Summary: "get raw swapin", QueryParams: bindQueryParams, Result: &apitypes.Swap{}},
+		{Methods: get, Path: "/swapout/{pairid}/{txid}/raw", Summary: "get raw swapout", QueryParams: bindQueryParams, Result: &apitypes.Swap{}},
+		{Methods: get, Path: "/swapin/{pairid}/{txid}/rawresult", Summary: "get raw swapin result", QueryParams: bindQueryParams, Result: &apitypes.SwapResult{}},
+		{Methods: get, Path: "/swapout/{pairid}/{txid}/rawresult", Summary: "get raw swapout result", QueryParams: bindQueryParams, Result: &apitypes.SwapResult{}},
+		{Methods: get, Path: "/swapin/history/{pairid}/{address}", Summary: "get swapin history", QueryParams: historyQueryParams, Result: []*apitypes.SwapInfo{}},
+		{Methods: get, Path: "/swapout/history/{pairid}/{address}", Summary: "get swapout history", QueryParams: historyQueryParams, Result: []*apitypes.SwapInfo{}},
+		{Methods: get, Path: "/swaps", Summary: "query swaps with filters and cursor pagination", QueryParams: querySwapsParams, Result: &apitypes.QuerySwapsResult{}},
+		{Methods: get, Path: "/quote/{pairid}/{direction}/{amount}", Summary: "estimate received value and fee", Result: &apitypes.QuoteSwapResult{}},
+		{Methods: get, Path: "/overview/{address}", Summary: "get overview of swaps and states of address", Result: &apitypes.AddressOverview{}},
+		{Methods: get, Path: "/nonces/{pairid}", Summary: "get swap nonces in database and on chain", Result: []*apitypes.SwapNonceInfo{}},
+		{Methods: getAndPost, Path: "/p2sh/{address}", Summary: "get p2sh address info", Result: &apitypes.P2shAddressInfo{}},
+		{Methods: getAndPost, Path: "/p2sh/bind/{address}", Summary: "register p2sh address of bind address", Result: &apitypes.P2shAddressInfo{}},
+		{Methods: getAndPost, Path: "/registered/{address}", Summary: "get registered address", Result: &apitypes.RegisteredAddress{}},
+		{Methods: getAndPost, Path: "/register/{address}", Summary: "register address", Result: apitypes.PostResult("")},
+		{Methods: get, Path: "/subscribe/ws", Summary: "subscribe swap status changes over websocket", QueryParams: subscribeQueryParams, Result: &apitypes.SwapEventMessage{}},
+		{Methods: get, Path: "/subscribe/sse", Summary: "subscribe swap status changes as server-sent events", QueryParams: subscribeQueryParams, Result: &apitypes.SwapEventMessage{}, ContentType: "text/event-stream"},
+		{Methods: get, Path: "/metrics", Summary: "prometheus metrics", Result: "", ContentType: "text/plain"},
+		{Methods: get, Path: "/openapi.json", Summary: "OpenAPI document of RESTful api", Result: map[string]interface{}{}},
+		{Methods: get, Path: "/openrpc.json", Summary: "OpenRPC document of JSON RPC api", Result: map[string]interface{}{}},
+		{Methods: get, Path: "/errors", Summary: "get error catalogue", Result: []*apierrors.ErrorInfo{}},
+	}
+}
+
+func getPathParams(path string) (names []string) {
+	for _, part := range strings.Split(path, "/") {
+		if strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}") {
+			names = append(names, part[1:len(part)-1])
+		}
+	}
+	return names
+}
+
+func (op *restOperation) toSpec(g *schemaGenerator, method string) map[string]interface{} {
+	parameters := make([]map[string]interface{}, 0)
+	for _, name := range getPathParams(op.Path) {
+		parameters = append(parameters, map[string]interface{}{
+			"name":        name,
+			"in":          "path",
+			"required":    true,
+			"description": pathParamDescriptions[name],
+			"schema":      schema{"type": "string"},
+		})
+	}
+	for _, param := range op.QueryParams {
+		parameters = append(parameters, map[string]interface{}{
+			"name":        param.Name,
+			"in":          "query",
+			"description": param.Description,
+			"schema":      schema{"type": param.Type},
+		})
+	}
+
+	contentType := op.ContentType
+	if contentType == "" {
+		contentType = "application/json"
+	}
+	resultContent := map[string]interface{}{
+		contentType: map[string]interface{}{"schema": g.schemaOf(op.Result)},
+	}
+	responses := make(map[string]interface{})
+	if op.IsHealth {
+		responses["200"] = map[string]interface{}{"description": "healthy", "content": resultContent}
+		responses["503"] = map[string]interface{}{"description": "unhealthy", "content": resultContent}
+	} else {
+		responses["200"] = map[string]interface{}{"description": "success", "content": resultContent}
+	}
+	// http status of error is given by the error catalogue (see /errors)
+	responses["default"] = map[string]interface{}{
+		"description": "error",
+		"content": map[string]interface{}{
+			"application/json": map[string]interface{}{"schema": g.schemaOf(&apierrors.Error{})},
+		},
+	}
+
+	return map[string]interface{}{
+		"summary":     op.Summary,
+		"operationId": getOperationID(method, op.Path),
+		"parameters":  parameters,
+		"responses":   responses,
+	}
+}
+
+// eg. 'GET /swapin/{pairid}/{txid}/raw' => 'getSwapinRaw'
+func getOperationID(method, path string) string {
+	id := method
+	for _, part := range strings.Split(path, "/") {
+		if part == "" || strings.HasPrefix(part, "{") {
+			continue
+		}
+		part = strings.TrimSuffix(part, ".json")
+		id += strings.ToUpper(part[:1]) + part[1:]
+	}
+	return id
+}
+
+// GetOpenAPISpec get OpenAPI 3 document of RESTful api
+func GetOpenAPISpec() map[string]interface{} {
+	openAPISpecOnce.Do(func() {
+		g := newSchemaGenerator()
+		paths := make(map[string]map[string]interface{})
+		for _, op := range getRestOperations() {
+			item, exist := paths[op.Path]
+			if !exist {
+				item = make(map[string]interface{})
+				paths[op.Path] = item
+			}
+			for _, method := range op.Methods {
+				item[method] = op.toSpec(g, method)
+			}
+		}
+		openAPISpec = map[string]interface{}{
+			"openapi": "3.0.3",
+			"info": map[string]interface{}{
+				"title":   "CrossChain-Bridge RESTful API",
+				"version": params.VersionWithMeta,
+			},
+			"paths": paths,
+			"components": map[string]interface{}{
+				"schemas": g.definitions,
+				"securitySchemes": map[string]interface{}{
+					"apiKey": map[string]interface{}{"type": "apiKey", "in": "header", "name": "X-API-Key"},
+				},
+			},
+			// api key is optional unless required by server config
+			"security": []map[string][]string{{}, {"apiKey": {}}},
+		}
+	})
+	return openAPISpec
+}
@@ -0,0 +1,119 @@
+package apidoc
+
+import (
+	"sync"
+
+	"github.com/anyswap/CrossChain-Bridge/params"
+	"github.com/anyswap/CrossChain-Bridge/rpc/apierrors"
+	"github.com/anyswap/CrossChain-Bridge/rpc/apitypes"
+)
+
+var (
+	openRPCSpec     map[string]interface{}
+	openRPCSpecOnce sync.Once
+)
+
+type rpcParam struct {
+	Name  string
+	Value interface{}
+}
+
+type rpcMethod struct {
+	Name    string
+	Summary string
+	Params  []*rpcParam
+	Result  interface{}
+}
+
+func getRPCMethods() []*rpcMethod {
+	txArgs := []*rpcParam{{"args", &apitypes.TxAndPairIDArgs{}}}
+	historyArgs := []*rpcParam{{"args", &apitypes.QueryHistoryArgs{}}}
+	pairIDArg := []*rpcParam{{"pairid", ""}}
+	addressArg := []*rpcParam{{"address", ""}}
+	return []*rpcMethod{
+		{Name: "GetVersionInfo", Summary: "get version info", Result: ""},
+		{Name: "GetErrorCatalogue", Summary: "get error catalogue", Result: []*apierrors.ErrorInfo{}},
+		{Name: "GetServerInfo", Summary: "get server info", Result: &apitypes.ServerInfo{}},
+		{Name: "GetTokenPairInfo", Summary: "get token pair info", Params: pairIDArg, Result: &apitypes.TokenPairInfo{}},
+		{Name: "GetSwapStatistics", Summary: "get swap statistics", Params: pairIDArg, Result: &apitypes.SwapStatistics{}},
+		{Name: "GetRawSwapin", Summary: "get raw swapin", Params: txArgs, Result: &apitypes.Swap{}},
+		{Name: "GetRawSwapinResult", Summary: "get raw swapin result", Params: txArgs, Result: &apitypes.SwapResult{}},
+		{Name: "GetSwapin", Summary: "get swapin", Params: txArgs, Result: &apitypes.SwapInfo{}},
+		{Name: "GetRawSwapout", Summary: "get raw swapout", Params: txArgs, Result: &apitypes.Swap{}},
+		{Name: "GetRawSwapoutResult", Summary: "get raw swapout result", Params: txArgs, Result: &apitypes.SwapResult{}},
+		{Name: "GetSwapout", Summary: "get swapout", Params: txArgs, Result: &apitypes.SwapInfo{}},
+		{Name: "QuerySwaps", Summary: "query swaps with filters and cursor pagination", Params: []*rpcParam{{"args", &apitypes.QuerySwapsArgs{}}}, Result: &apitypes.QuerySwapsResult{}},
+		{Name: "QuoteSwap", Summary: "estimate received value and fee", Params: []*rpcParam{{"args", &apitypes.QuoteSwapArgs{}}}, Result: &apitypes.QuoteSwapResult{}},
+		{Name: "GetAddressOverview", Summary: "get overview of swaps and states of address", Params: addressArg, Result: &apitypes.AddressOverview{}},
+		{Name: "GetSwapNonces", Summary: "get swap nonces in database and on chain", Params: pairIDArg, Result: []*apitypes.SwapNonceInfo{}},
+		{Name: "GetSwapinHistory", Summary: "get swapin history", Params: historyArgs, Result: []*apitypes.SwapInfo{}},
+		{Name: "GetSwapoutHistory", Summary: "get swapout history", Params: historyArgs, Result: []*apitypes.SwapInfo{}},
+		{Name: "Swapin", Summary: "post swapin", Params: txArgs, Result: apitypes.PostResult("")},
+		{Name: "RetrySwapin", Summary: "retry swapin", Params: txArgs, Result: apitypes.PostResult("")},
+		{Name: "P2shSwapin", Summary: "post p2sh swapin", Params: []*rpcParam{{"args", &apitypes.P2shSwapinArgs{}}}, Result: apitypes.PostResult("")},
+		{Name: "Swapout", Summary: "post swapout", Params: txArgs, Result: apitypes.PostResult("")},
+		{Name: "IsValidSwapinBindAddress", Summary: "check swapin bind address", Params: addressArg, Result: false},
+		{Name: "IsValidSwapoutBindAddress", Summary: "check swapout bind address", Params: addressArg, Result: false},
+		{Name: "RegisterP2shAddress", Summary: "register p2sh address of bind address", Params: []*rpcParam{{"bindaddress", ""}}, Result: &apitypes.P2shAddressInfo{}},
+		{Name: "GetP2shAddressInfo", Summary: "get p2sh address info", Params: []*rpcParam{{"p2shaddress", ""}}, Result: &apitypes.P2shAddressInfo{}},
+		{Name: "GetLatestScanInfo", Summary: "get latest scan info", Params: []*rpcParam{{"issrc", false}}, Result: &apitypes.LatestScanInfo{}},
+		{Name: "RegisterAddress", Summary: "register address", Params: addressArg, Result: apitypes.PostResult("")},
+		{Name: "GetRegisteredAddress", Summary: "get registered address", Params: addressArg, Result: &apitypes.RegisteredAddress{}},
+		{Name: "AdminCall", Summary: "call admin method with signed raw tx", Params: []*rpcParam{{"rawtx", ""}}, Result: ""},
+	}
+}
+
+func (m *rpcMethod) toSpec(g *schemaGenerator) map[string]interface{} {
+	parameters := make([]map[string]interface{}, 0, len(m.Params))
+	for _, param := range m.Params {
+		parameters = append(parameters, map[string]interface{}{
+			"name":     param.Name,
+			"required": true,
+			"schema":   g.schemaOf(param.Value),
+		})
+	}
+	return map[string]interface{}{
+		"name":           "swap." + m.Name,
+		"errors":         []map[string]interface{}{{"$ref": "#/components/errors/ApiError"}},
+		"summary":        m.Summary,
+		"paramStructure": "by-position",
+		"params":         parameters,
+		"result": map[string]interface{}{
+			"name":   "result",
+			"schema": g.schemaOf(m.Result),
+		},
+	}
+}
+
+// GetOpenRPCSpec get OpenRPC document of json rpc api
+func GetOpenRPCSpec() map[string]interface{} {
+	openRPCSpecOnce.Do(func() {
+		g := newSchemaGenerator()
+		rpcMethods := getRPCMethods()
+		methods := make([]map[string]interface{}, 0, len(rpcMethods))
+		for _, m := range rpcMethods {
+			methods = append(methods, m.toSpec(g))
+		}
+		openRPCSpec = map[string]interface{}{
+			"openrpc": "1.2.6",
+			"info": map[string]interface{}{
+				"title":   "CrossChain-Bridge JSON RPC API",
+				"version": params.VersionWithMeta,
+			},
+			"servers": []map[string]interface{}{
+				{"name": "swap server", "url": "/rpc"},
+			},
+			"methods": methods,
+			"components": map[string]interface{}{
+				"schemas": g.definitions,
+				"errors": map[string]interface{}{
+					"ApiError": map[string]interface{}{
+						"code":    apierrors.InternalError.Code,
+						"message": "error codes are listed in the error catalogue (swap.GetErrorCatalogue), and the error name is in data",
+					},
+				},
+			},
+		}
+	})
+	return openRPCSpec
+}
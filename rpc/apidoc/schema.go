@@ -0,0 +1,140 @@
+// Package apidoc generates OpenAPI and OpenRPC documents of swap server api
+package apidoc
+
+import (
+	"encoding"
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"strings"
+	"time"
+)
+
+const schemaRefPrefix = "#/components/schemas/"
+
+var (
+	bigIntType        = reflect.TypeOf(big.Int{})
+	timeType          = reflect.TypeOf(time.Time{})
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// schema json schema object
+type schema = map[string]interface{}
+
+// schemaGenerator generates json schema from go types by reflection,
+// named struct types are put into definitions and referenced by name.
+type schemaGenerator struct {
+	definitions map[string]schema
+	names       map[reflect.Type]string
+}
+
+func newSchemaGenerator() *schemaGenerator {
+	return &schemaGenerator{
+		definitions: make(map[string]schema),
+		names:       make(map[reflect.Type]string),
+	}
+}
+
+// schemaOf get json schema of the type of value (value can be nil)
+func (g *schemaGenerator) schemaOf(value interface{}) schema {
+	if value == nil {
+		return schema{}
+	}
+	return g.schemaOfType(reflect.TypeOf(value))
+}
+
+func implements(t, iface reflect.Type) bool {
+	return t.Implements(iface) || reflect.PtrTo(t).Implements(iface)
+}
+
+// nolint:gocyclo // allow big simple switch
+func (g *schemaGenerator) schemaOfType(t reflect.Type) schema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch {
+	case t == bigIntType:
+		return schema{"type": "integer"}
+	case t == timeType:
+		return schema{"type": "string", "format": "date-time"}
+	case t.Kind() != reflect.Interface && (implements(t, jsonMarshalerType) || implements(t, textMarshalerType)):
+		return schema{"type": "string"}
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return schema{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return schema{"type": "integer"}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return schema{"type": "integer", "minimum": 0}
+	case reflect.Float32, reflect.Float64:
+		return schema{"type": "number"}
+	case reflect.String:
+		return schema{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return schema{"type": "string", "format": "byte"}
+		}
+		return schema{"type": "array", "items": g.schemaOfType(t.Elem())}
+	case reflect.Map:
+		return schema{"type": "object", "additionalProperties": g.schemaOfType(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return g.structSchema(t)
+		}
+		return g.refSchema(t)
+	default: // interface, etc.
+		return schema{}
+	}
+}
+
+func (g *schemaGenerator) refSchema(t reflect.Type) schema {
+	name, exist := g.names[t]
+	if !exist {
+		name = t.Name()
+		if _, conflict := g.definitions[name]; conflict {
+			pkgPath := strings.Split(t.PkgPath(), "/")
+			pkgName := pkgPath[len(pkgPath)-1]
+			name = strings.ToUpper(pkgName[:1]) + pkgName[1:] + name
+		}
+		g.names[t] = name
+		g.definitions[name] = schema{} // placeholder for recursive types
+		g.definitions[name] = g.structSchema(t)
+	}
+	return schema{"$ref": schemaRefPrefix + name}
+}
+
+func (g *schemaGenerator) structSchema(t reflect.Type) schema {
+	properties := make(map[string]schema)
+	g.addStructFields(t, properties)
+	return schema{"type": "object", "properties": properties}
+}
+
+func (g *schemaGenerator) addStructFields(t reflect.Type, properties map[string]schema) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		if field.Anonymous && name == "" {
+			fieldType := field.Type
+			if fieldType.Kind() == reflect.Ptr {
+				fieldType = fieldType.Elem()
+			}
+			if fieldType.Kind() == reflect.Struct {
+				g.addStructFields(fieldType, properties)
+				continue
+			}
+		}
+		if field.PkgPath != "" { // unexported
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		properties[name] = g.schemaOfType(field.Type)
+	}
+}
@@ -0,0 +1,127 @@
+package apierrors
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// api errors. codes of existing json rpc errors are kept for compatibility.
+var (
+	InternalError      = &ErrorInfo{Code: -32000, Name: "INTERNAL_ERROR", HTTPStatus: http.StatusInternalServerError, Description: "internal server error"}
+	DatabaseError      = &ErrorInfo{Code: -32001, Name: "DATABASE_ERROR", HTTPStatus: http.StatusInternalServerError, Description: "database error"}
+	Unauthorized       = &ErrorInfo{Code: -32020, Name: "UNAUTHORIZED", HTTPStatus: http.StatusUnauthorized, Description: "api key is invalid or missing"}
+	RateLimited        = &ErrorInfo{Code: -32021, Name: "RATE_LIMITED", HTTPStatus: http.StatusTooManyRequests, Description: "rate limit exceeded"}
+	BadRequest         = &ErrorInfo{Code: -32022, Name: "BAD_REQUEST", HTTPStatus: http.StatusBadRequest, Description: "malformed request"}
+	MethodNotAllowed   = &ErrorInfo{Code: -32023, Name: "METHOD_NOT_ALLOWED", HTTPStatus: http.StatusMethodNotAllowed, Description: "http method is not allowed"}
+	Forbidden          = &ErrorInfo{Code: -32024, Name: "FORBIDDEN", HTTPStatus: http.StatusForbidden, Description: "caller has no permission"}
+	ServiceUnavailable = &ErrorInfo{Code: -32025, Name: "SERVICE_UNAVAILABLE", HTTPStatus: http.StatusServiceUnavailable, Description: "service is temporarily unavailable"}
+	AdminTxReplayed    = &ErrorInfo{Code: -32026, Name: "ADMIN_TX_REPLAYED", HTTPStatus: http.StatusConflict, Description: "admin tx is already called"}
+	InvalidArgument    = &ErrorInfo{Code: -32093, Name: "INVALID_ARGUMENT", HTTPStatus: http.StatusBadRequest, Description: "wrong arguments"}
+	SwapCannotRetry    = &ErrorInfo{Code: -32094, Name: "SWAP_CANNOT_RETRY", HTTPStatus: http.StatusConflict, Description: "swap can not retry"}
+	TokenPairNotExist  = &ErrorInfo{Code: -32095, Name: "TOKEN_PAIR_NOT_EXIST", HTTPStatus: http.StatusNotFound, Description: "token pair not exist"}
+	NotBtcBridge       = &ErrorInfo{Code: -32096, Name: "NOT_BTC_BRIDGE", HTTPStatus: http.StatusBadRequest, Description: "bridge is not btc"}
+	VerifySwapFailed   = &ErrorInfo{Code: -32099, Name: "VERIFY_SWAP_FAILED", HTTPStatus: http.StatusBadRequest, Description: "verify swap failed"}
+)
+
+var (
+	catalogue = []*ErrorInfo{
+		InternalError,
+		DatabaseError,
+		{Code: -32002, Name: "ITEM_NOT_FOUND", HTTPStatus: http.StatusNotFound, Description: "item not found"},
+		{Code: -32003, Name: "ITEM_IS_DUP", HTTPStatus: http.StatusConflict, Description: "item is duplicate"},
+		{Code: -32011, Name: "SWAP_NOT_FOUND", HTTPStatus: http.StatusNotFound, Description: "swap is not found"},
+		{Code: -32012, Name: "WRONG_KEY", HTTPStatus: http.StatusBadRequest, Description: "wrong key"},
+		{Code: -32013, Name: "WRONG_CURSOR", HTTPStatus: http.StatusBadRequest, Description: "wrong cursor"},
+		Unauthorized,
+		RateLimited,
+		BadRequest,
+		MethodNotAllowed,
+		Forbidden,
+		ServiceUnavailable,
+		AdminTxReplayed,
+		InvalidArgument,
+		SwapCannotRetry,
+		TokenPairNotExist,
+		NotBtcBridge,
+		VerifySwapFailed,
+
+		newErrorInfo(-32100, "SWAP_TYPE_NOT_SUPPORTED", http.StatusBadRequest, "swap type not supported in this endpoint"),
+		newErrorInfo(-32101, "BRIDGE_SOURCE_NOT_SUPPORTED", http.StatusBadRequest, "bridge source not supported"),
+		newErrorInfo(-32102, "BRIDGE_DESTINATION_NOT_SUPPORTED", http.StatusBadRequest, "bridge destination not supported"),
+		newErrorInfo(-32103, "UNKNOWN_SWAP_TYPE", http.StatusBadRequest, "unknown swap type"),
+		newErrorInfo(-32104, "MSG_HASH_MISMATCH", http.StatusBadRequest, "message hash mismatch"),
+		newErrorInfo(-32105, "WRONG_COUNT_OF_MSG_HASHES", http.StatusBadRequest, "wrong count of msg hashed"),
+		newErrorInfo(-32106, "WRONG_RAW_TX", http.StatusBadRequest, "wrong raw tx"),
+		newErrorInfo(-32107, "WRONG_EXTRA_ARGS", http.StatusBadRequest, "wrong extra args"),
+		newErrorInfo(-32108, "NO_BTC_BRIDGE", http.StatusBadRequest, "no btc bridge exist"),
+		newErrorInfo(-32109, "WRONG_SWAPIN_TX_TYPE", http.StatusBadRequest, "wrong swapin tx type"),
+		newErrorInfo(-32110, "BUILD_SWAP_TX_IN_WRONG_ENDPOINT", http.StatusBadRequest, "build swap in/out tx in wrong endpoint"),
+		newErrorInfo(-32111, "TX_BEFORE_INITIAL_HEIGHT", http.StatusBadRequest, "transaction before initial block height"),
+		newErrorInfo(-32112, "ADDRESS_IS_IN_BLACKLIST", http.StatusForbidden, "address is in black list"),
+		newErrorInfo(-32113, "NOT_MIGRATING", http.StatusConflict, "dcrm address is not in rotation"),
+		newErrorInfo(-32114, "RETIRING_HAS_PENDING_TXS", http.StatusConflict, "retiring dcrm address has pending txs"),
+		newErrorInfo(-32115, "NOTHING_TO_SWEEP", http.StatusConflict, "nothing to sweep"),
+		newErrorInfo(-32116, "TODO", http.StatusNotImplemented, "developing: TODO"),
+		newErrorInfo(-32117, "TX_NOT_FOUND", http.StatusNotFound, "tx not found"),
+		newErrorInfo(-32118, "TX_NOT_STABLE", http.StatusConflict, "tx not stable"),
+		newErrorInfo(-32119, "TX_WITH_WRONG_RECEIVER", http.StatusBadRequest, "tx with wrong receiver"),
+		newErrorInfo(-32120, "TX_WITH_WRONG_CONTRACT", http.StatusBadRequest, "tx with wrong contract"),
+		newErrorInfo(-32121, "TX_WITH_WRONG_INPUT", http.StatusBadRequest, "tx with wrong input data"),
+		newErrorInfo(-32122, "TX_WITH_WRONG_LOG_DATA", http.StatusBadRequest, "tx with wrong log data"),
+		newErrorInfo(-32123, "TX_IS_AGGREGATE_TX", http.StatusBadRequest, "tx is aggregate tx"),
+		newErrorInfo(-32124, "WRONG_P2SH_BIND_ADDRESS", http.StatusBadRequest, "wrong p2sh bind address"),
+		newErrorInfo(-32125, "TX_FUNC_HASH_MISMATCH", http.StatusBadRequest, "tx func hash mismatch"),
+		newErrorInfo(-32126, "DEPOSIT_LOG_NOT_FOUND", http.StatusBadRequest, "deposit log not found or removed"),
+		newErrorInfo(-32127, "SWAPOUT_LOG_NOT_FOUND", http.StatusBadRequest, "swapout log not found or removed"),
+		newErrorInfo(-32128, "UNKNOWN_PAIR_ID", http.StatusNotFound, "unknown pair ID"),
+		newErrorInfo(-32129, "BIND_ADDRESS_MISMATCH", http.StatusBadRequest, "bind address mismatch"),
+		newErrorInfo(-32130, "TX_WITH_WRONG_MEMO", http.StatusBadRequest, "tx with wrong memo"),
+		newErrorInfo(-32131, "TX_WITH_WRONG_VALUE", http.StatusBadRequest, "tx with wrong value"),
+		newErrorInfo(-32132, "TX_WITH_WRONG_RECEIPT", http.StatusBadRequest, "tx with wrong receipt"),
+		newErrorInfo(-32133, "TX_WITH_WRONG_SENDER", http.StatusBadRequest, "tx with wrong sender"),
+		newErrorInfo(-32134, "TX_SENDER_NOT_REGISTERED", http.StatusBadRequest, "tx sender not registered"),
+		newErrorInfo(-32135, "TX_INCOMPATIBLE", http.StatusBadRequest, "tx incompatible"),
+		newErrorInfo(-32136, "BIND_ADDR_IS_CONTRACT", http.StatusBadRequest, "bind address is contract"),
+		newErrorInfo(-32137, "RPC_QUERY_ERROR", http.StatusBadGateway, "rpc query error"),
+	}
+
+	catalogueByCode = make(map[int]*ErrorInfo, len(catalogue))
+)
+
+func init() {
+	for _, info := range catalogue {
+		if _, exist := catalogueByCode[info.Code]; exist {
+			panic("duplicate api error code " + info.Name)
+		}
+		catalogueByCode[info.Code] = info
+	}
+}
+
+func newErrorInfo(code int, name string, httpStatus int, description string) *ErrorInfo {
+	return &ErrorInfo{
+		Code:        code,
+		Name:        name,
+		HTTPStatus:  httpStatus,
+		Description: description,
+	}
+}
+
+// BindError bind sentinel error to catalogue entry of code, so that errors
+// wrapping it are converted to the entry. it should be called in init functions.
+func BindError(code int, err error) {
+	info := catalogueByCode[code]
+	if info == nil {
+		panic(fmt.Sprintf("bind error to unknown api error code %v", code))
+	}
+	info.err = err
+}
+
+// GetErrorInfo get catalogue entry of code
+func GetErrorInfo(code int) *ErrorInfo {
+	return catalogueByCode[code]
+}
+
+// GetCatalogue get all entries of error catalogue
+func GetCatalogue() []*ErrorInfo {
+	return catalogue
+}
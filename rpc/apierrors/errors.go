@@ -0,0 +1,166 @@
+// Package apierrors defines the error catalogue of swap server api
+package apierrors
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	rpcjson "github.com/gorilla/rpc/v2/json2"
+)
+
+// ErrorInfo error catalogue entry
+type ErrorInfo struct {
+	Code        int    `json:"code"`
+	Name        string `json:"name"`
+	HTTPStatus  int    `json:"httpstatus"`
+	Description string `json:"description"`
+
+	err error // sentinel error matched by errors.Is
+}
+
+// Error api error with stable code and name
+type Error struct {
+	Code    int    `json:"code"`
+	Name    string `json:"name"`
+	Message string `json:"message"`
+
+	httpStatus int
+	cause      error
+}
+
+// ErrorData data of json rpc error
+type ErrorData struct {
+	Name string `json:"name"`
+}
+
+// Error impl error interface
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Unwrap get the cause error
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
+// HTTPStatus get http status code of RESTful api response
+func (e *Error) HTTPStatus() int {
+	if e.httpStatus == 0 {
+		if info := GetErrorInfo(e.Code); info != nil {
+			return info.HTTPStatus
+		}
+		return http.StatusInternalServerError
+	}
+	return e.httpStatus
+}
+
+// New new api error of this entry
+func (info *ErrorInfo) New(message string) *Error {
+	return &Error{
+		Code:       info.Code,
+		Name:       info.Name,
+		Message:    message,
+		httpStatus: info.HTTPStatus,
+	}
+}
+
+// Errorf new api error of this entry with formatted message
+func (info *ErrorInfo) Errorf(format string, args ...interface{}) *Error {
+	return info.New(fmt.Sprintf(format, args...))
+}
+
+// Wrap wrap err as api error of this entry, the message is prefix + err.Error().
+// if err is or wraps a more specific error in the catalogue, use that entry instead.
+func (info *ErrorInfo) Wrap(prefix string, err error) *Error {
+	target := info
+	if specific := matchErrorInfo(err); specific != nil {
+		target = specific
+	}
+	apiErr := target.New(prefix + err.Error())
+	apiErr.cause = err
+	return apiErr
+}
+
+func matchErrorInfo(err error) *ErrorInfo {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return GetErrorInfo(apiErr.Code)
+	}
+	for _, info := range catalogue {
+		if info.err != nil && errors.Is(err, info.err) {
+			return info
+		}
+	}
+	var rpcErr *rpcjson.Error
+	if errors.As(err, &rpcErr) {
+		return GetErrorInfo(int(rpcErr.Code))
+	}
+	return nil
+}
+
+// Convert convert any error to api error in the catalogue,
+// unknown errors are converted to internal error.
+func Convert(err error) *Error {
+	if err == nil {
+		return nil
+	}
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+	info := matchErrorInfo(err)
+	if info == nil {
+		info = InternalError
+	}
+	apiErr = info.New(err.Error())
+	apiErr.cause = err
+	return apiErr
+}
+
+// ToRPCError convert error to json rpc error with name in data.
+// json rpc errors of standard codes (eg. parse error) are returned as is.
+func ToRPCError(err error) error {
+	if rpcErr, ok := err.(*rpcjson.Error); ok && GetErrorInfo(int(rpcErr.Code)) == nil {
+		return rpcErr
+	}
+	apiErr := Convert(err)
+	return &rpcjson.Error{
+		Code:    rpcjson.ErrorCode(apiErr.Code),
+		Message: apiErr.Message,
+		Data:    &ErrorData{Name: apiErr.Name},
+	}
+}
+
+// FromRPCError convert json rpc error returned by server to api error
+func FromRPCError(code int, message string, data interface{}) *Error {
+	apiErr := &Error{Code: code, Message: message}
+	if info := GetErrorInfo(code); info != nil {
+		apiErr.Name = info.Name
+		apiErr.httpStatus = info.HTTPStatus
+	} else if m, ok := data.(map[string]interface{}); ok {
+		apiErr.Name, _ = m["name"].(string)
+	}
+	return apiErr
+}
+
+// GetCode get api error code of err, returns 0 if err is nil
+func GetCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	return Convert(err).Code
+}
+
+// Is report whether err has the same catalogue code as target,
+// it works for errors returned by remote server where errors.Is does not.
+func Is(err, target error) bool {
+	if err == nil || target == nil {
+		return err == target
+	}
+	if errors.Is(err, target) {
+		return true
+	}
+	info := matchErrorInfo(target)
+	return info != nil && Convert(err).Code == info.Code
+}
@@ -0,0 +1,347 @@
+// Package apitypes defines the types of swap server api
+package apitypes
+
+// types here are plain data transfer objects with the same json encoding
+// as the server side types, so that clients need not import server packages.
+
+// SwapStatus swap status, see mongodb.SwapStatus for values
+type SwapStatus uint16
+
+// Swap registered swap
+type Swap struct {
+	Key       string
+	PairID    string
+	TxID      string
+	TxTo      string
+	TxType    uint32
+	Bind      string
+	Status    SwapStatus
+	InitTime  int64
+	Timestamp int64
+	Memo      string
+}
+
+// SwapResult swap result
+type SwapResult struct {
+	Key        string
+	PairID     string
+	TxID       string
+	TxTo       string
+	TxHeight   uint64
+	TxTime     uint64
+	From       string
+	To         string
+	Bind       string
+	Value      string
+	SwapTx     string
+	OldSwapTxs []string
+	SwapHeight uint64
+	SwapTime   uint64
+	SwapValue  string
+	SwapType   uint32
+	SwapNonce  uint64
+	SwapFrom   string
+	Status     SwapStatus
+	InitTime   int64
+	Timestamp  int64
+	Memo       string
+}
+
+// SwapStatistics swap statistics
+type SwapStatistics struct {
+	PairID              string
+	TotalSwapinCount    int
+	TotalSwapoutCount   int
+	PendingSwapinCount  int
+	PendingSwapoutCount int
+	StableSwapinCount   int
+	TotalSwapinValue    string
+	TotalSwapinFee      string
+	StableSwapoutCount  int
+	TotalSwapoutValue   string
+	TotalSwapoutFee     string
+}
+
+// LatestScanInfo latest scan info
+type LatestScanInfo struct {
+	Key         string
+	BlockHeight uint64
+	Timestamp   int64
+}
+
+// RegisteredAddress registered address
+type RegisteredAddress struct {
+	Key       string
+	Timestamp int64
+}
+
+// ServerInfo server info
+type ServerInfo struct {
+	Identifier          string
+	MustRegisterAccount bool
+	SrcChain            *ChainConfig
+	DestChain           *ChainConfig
+	PairIDs             []string
+	Version             string
+	MaintenanceWindows  []*MaintenanceWindow
+}
+
+// ChainConfig chain config
+type ChainConfig struct {
+	BlockChain     string
+	NetID          string
+	Confirmations  *uint64
+	InitialHeight  *uint64
+	EnableScan     bool
+	EnableScanPool bool
+	ScanReceipt    bool `json:",omitempty"`
+
+	MaxGasPriceFluctPercent uint64 `json:",omitempty"`
+	WaitTimeToReplace       int64  // seconds
+	MaxReplaceCount         int
+	EnableReplaceSwap       bool
+
+	MaxBlockAge int64  `json:",omitempty"` // seconds
+	MaxScanLag  uint64 `json:",omitempty"` // blocks
+}
+
+// MaintenanceWindow maintenance window of token pair
+type MaintenanceWindow struct {
+	ID        string
+	PairID    string
+	Direction string // deposit, withdraw or both
+	StartTime int64  // unix seconds, inclusive
+	EndTime   int64  // unix seconds, exclusive
+	Reason    string `json:",omitempty"`
+	CreatedBy string `json:",omitempty"`
+}
+
+// TokenPairInfo token pair info
+type TokenPairInfo struct {
+	PairID    string
+	SrcToken  *TokenInfo
+	DestToken *TokenInfo
+}
+
+// TokenInfo token info
+type TokenInfo struct {
+	ID                     string `json:",omitempty"`
+	Name                   string
+	Symbol                 string
+	Decimals               *uint8
+	Description            string `json:",omitempty"`
+	DepositAddress         string `json:",omitempty"`
+	DcrmAddress            string
+	ContractAddress        string   `json:",omitempty"`
+	ContractCodeHash       string   `json:",omitempty"`
+	MaximumSwap            *float64 // whole unit (eg. BTC, ETH, FSN), not Satoshi
+	MinimumSwap            *float64 // whole unit
+	BigValueThreshold      *float64
+	SwapFeeRate            *float64
+	MaximumSwapFee         *float64
+	MinimumSwapFee         *float64
+	PlusGasPricePercentage uint64 `json:",omitempty"`
+	DisableSwap            bool
+	IsDelegateContract     bool
+	DelegateToken          string `json:",omitempty"`
+
+	DefaultGasLimit         uint64 `json:",omitempty"`
+	AllowSwapinFromContract bool   `json:",omitempty"`
+
+	RetiringDcrmAddress string     `json:",omitempty"`
+	SwapState           *SwapState `json:",omitempty"`
+}
+
+// SwapState runtime swap state set by admin
+type SwapState struct {
+	DisableSwap bool
+	Reason      string `json:",omitempty"`
+	SetBy       string `json:",omitempty"`
+	Timestamp   int64
+}
+
+// P2shAddressInfo p2sh address info
+type P2shAddressInfo struct {
+	BindAddress        string
+	P2shAddress        string
+	RedeemScript       string
+	RedeemScriptDisasm string
+}
+
+// PostResult post result
+type PostResult string
+
+// SuccessPostResult success post result
+var SuccessPostResult PostResult = "Success"
+
+// QueuedPostResult post result of swap registered during maintenance window,
+// which is registered after the window ended
+var QueuedPostResult PostResult = "Queued"
+
+// SwapInfo swap info
+type SwapInfo struct {
+	PairID        string     `json:"pairid"`
+	TxID          string     `json:"txid"`
+	TxTo          string     `json:"txto"`
+	TxHeight      uint64     `json:"txheight"`
+	TxTime        uint64     `json:"txtime"`
+	From          string     `json:"from"`
+	To            string     `json:"to"`
+	Bind          string     `json:"bind"`
+	Value         string     `json:"value"`
+	SwapTx        string     `json:"swaptx"`
+	SwapHeight    uint64     `json:"swapheight"`
+	SwapTime      uint64     `json:"swaptime"`
+	SwapValue     string     `json:"swapvalue"`
+	SwapType      uint32     `json:"swaptype"`
+	SwapNonce     uint64     `json:"swapnonce"`
+	Status        SwapStatus `json:"status"`
+	StatusMsg     string     `json:"statusmsg"`
+	InitTime      int64      `json:"inittime"`
+	Timestamp     int64      `json:"timestamp"`
+	Memo          string     `json:"memo"`
+	Confirmations uint64     `json:"confirmations"`
+}
+
+// QuerySwapsArgs query swaps args, empty fields are ignored
+type QuerySwapsArgs struct {
+	SwapType     string       `json:"swaptype"` // swapin, swapout, or empty for both
+	PairID       string       `json:"pairid"`
+	Address      string       `json:"address"`
+	Bind         string       `json:"bind"`
+	Status       []SwapStatus `json:"status"`
+	InitTimeFrom int64        `json:"inittimefrom"` // milliseconds, inclusive
+	InitTimeTo   int64        `json:"inittimeto"`   // milliseconds, exclusive
+	TxTimeFrom   uint64       `json:"txtimefrom"`   // seconds, inclusive
+	TxTimeTo     uint64       `json:"txtimeto"`     // seconds, exclusive
+	MinValue     string       `json:"minvalue"`
+	MaxValue     string       `json:"maxvalue"`
+	HasSwapTx    *bool        `json:"hasswaptx"`
+	Order        string       `json:"order"` // desc (default) or asc by init time
+	Cursor       string       `json:"cursor"`
+	Limit        int          `json:"limit"`
+}
+
+// QuerySwapsResult query swaps result
+type QuerySwapsResult struct {
+	Swaps      []*SwapInfo `json:"swaps"`
+	NextCursor string      `json:"nextcursor"`
+}
+
+// QuoteSwapResult quote swap result
+type QuoteSwapResult struct {
+	PairID                string `json:"pairid"`
+	SwapType              string `json:"swaptype"`
+	Value                 string `json:"value"`
+	SwapValue             string `json:"swapvalue"`
+	SwapFee               string `json:"swapfee"`
+	IsInRange             bool   `json:"isinrange"`
+	IsBigValue            bool   `json:"isbigvalue"`
+	DisableSwap           bool   `json:"disableswap"`
+	ExpectedConfirmations uint64 `json:"expectedconfirmations"`
+}
+
+// SwapEventMessage swap status push message
+type SwapEventMessage struct {
+	IsSwapin bool      `json:"isswapin"`
+	Swap     *SwapInfo `json:"swap"`
+}
+
+// AddressOverview overview of the swaps and states of an address across all token pairs
+type AddressOverview struct {
+	Address         string                 `json:"address"`
+	IsRegistered    bool                   `json:"isregistered"`
+	P2shAddress     string                 `json:"p2shaddress,omitempty"`     // p2sh deposit address bound to this address
+	P2shBindAddress string                 `json:"p2shbindaddress,omitempty"` // bind address if this is a p2sh deposit address
+	Pairs           []*AddressPairOverview `json:"pairs"`
+	PendingSwaps    []*AddressSwapInfo     `json:"pendingswaps"`
+	FailedSwaps     []*AddressSwapInfo     `json:"failedswaps"`
+}
+
+// AddressPairOverview overview of an address in a token pair
+type AddressPairOverview struct {
+	PairID        string             `json:"pairid"`
+	IsBlacklisted bool               `json:"isblacklisted"`
+	Swapin        *AddressSwapTotals `json:"swapin"`
+	Swapout       *AddressSwapTotals `json:"swapout"`
+}
+
+// AddressSwapTotals swap totals of an address, values are sums of the succeeded swaps
+type AddressSwapTotals struct {
+	TotalCount     int    `json:"totalcount"`
+	SucceedCount   int    `json:"succeedcount"`
+	TotalValue     string `json:"totalvalue"`
+	TotalSwapValue string `json:"totalswapvalue"`
+}
+
+// AddressSwapInfo swap info with its direction, and current stage if pending or reason if failed
+type AddressSwapInfo struct {
+	*SwapInfo
+	IsSwapin bool   `json:"isswapin"`
+	Stage    string `json:"stage,omitempty"`
+	Reason   string `json:"reason,omitempty"`
+}
+
+// SwapNonceInfo nonce of swap signer in database and on chain
+type SwapNonceInfo struct {
+	Address      string   `json:"address"`
+	IsSwapin     bool     `json:"isswapin"`
+	PairIDs      []string `json:"pairids"`
+	DBNonce      uint64   `json:"dbnonce"`
+	LatestNonce  uint64   `json:"latestnonce"`
+	PendingNonce uint64   `json:"pendingnonce"`
+	Error        string   `json:"error,omitempty"`
+}
+
+// TxAndPairIDArgs txid and pairID args
+type TxAndPairIDArgs struct {
+	TxID   string `json:"txid"`
+	PairID string `json:"pairid"`
+	Bind   string `json:"bind"`
+}
+
+// QueryHistoryArgs query history args
+type QueryHistoryArgs struct {
+	Address string `json:"address"`
+	PairID  string `json:"pairid"`
+	Offset  int    `json:"offset"`
+	Limit   int    `json:"limit"`
+}
+
+// P2shSwapinArgs p2sh swapin args
+type P2shSwapinArgs struct {
+	TxID string `json:"txid"`
+	Bind string `json:"bind"`
+}
+
+// QuoteSwapArgs quote swap args
+type QuoteSwapArgs struct {
+	PairID    string `json:"pairid"`
+	Direction string `json:"direction"`
+	Amount    string `json:"amount"`
+}
+
+// health status
+const (
+	HealthStatusOK   = "ok"
+	HealthStatusFail = "fail"
+)
+
+// ComponentHealth health of a component
+type ComponentHealth struct {
+	Status  string      `json:"status"`
+	Error   string      `json:"error,omitempty"`
+	Details interface{} `json:"details,omitempty"`
+}
+
+// HealthResult health check result
+type HealthResult struct {
+	Status     string                      `json:"status"`
+	Timestamp  int64                       `json:"timestamp"`
+	Components map[string]*ComponentHealth `json:"components"`
+}
+
+// IsHealthy is all components healthy
+func (r *HealthResult) IsHealthy() bool {
+	return r.Status == HealthStatusOK
+}
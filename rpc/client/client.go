@@ -9,6 +9,8 @@ import (
 	"net/http"
 	"strings"
 	"time"
+
+	"github.com/anyswap/CrossChain-Bridge/internal/metrics"
 )
 
 var (
@@ -133,7 +135,11 @@ func addRawPostBody(req *http.Request, body string) (err error) {
 	return err
 }
 
-func doRequest(req *http.Request, timeoutSeconds int) (*http.Response, error) {
+func doRequest(req *http.Request, timeoutSeconds int) (resp *http.Response, err error) {
+	start := time.Now()
+	defer func() {
+		metrics.RecordRPCRequest(req.URL.String(), start, err)
+	}()
 	timeout := time.Duration(timeoutSeconds) * time.Second
 	if httpClient == nil {
 		client := http.Client{
@@ -21,6 +21,7 @@ type Request struct {
 	Params  interface{}
 	Timeout int
 	ID      int
+	Headers map[string]string
 }
 
 // NewRequest new request
@@ -63,20 +64,22 @@ type RequestBody struct {
 	ID      int         `json:"id"`
 }
 
-type jsonError struct {
+// JSONError json rpc error
+type JSONError struct {
 	Code    int         `json:"code"`
 	Message string      `json:"message"`
 	Data    interface{} `json:"data,omitempty"`
 }
 
-func (err *jsonError) Error() string {
+// Error impl error interface
+func (err *JSONError) Error() string {
 	return fmt.Sprintf("json-rpc error %d, %s", err.Code, err.Message)
 }
 
 type jsonrpcResponse struct {
 	Version string          `json:"jsonrpc,omitempty"`
 	ID      json.RawMessage `json:"id,omitempty"`
-	Error   *jsonError      `json:"error,omitempty"`
+	Error   *JSONError      `json:"error,omitempty"`
 	Result  json.RawMessage `json:"result,omitempty"`
 }
 
@@ -88,7 +91,7 @@ func RPCPostRequest(url string, req *Request, result interface{}) error {
 		Params:  req.Params,
 		ID:      req.ID,
 	}
-	resp, err := HTTPPost(url, reqBody, nil, nil, req.Timeout)
+	resp, err := HTTPPost(url, reqBody, nil, req.Headers, req.Timeout)
 	if err != nil {
 		log.Trace("post rpc error", "url", url, "method", req.Method, "err", err)
 		return err
@@ -0,0 +1,68 @@
+package grpcapi
+
+import (
+	"context"
+	"io"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// APIKeyMetadataKey metadata key of api key
+const APIKeyMetadataKey = "x-api-key"
+
+// Dial dial swap server grpc address (eg. 127.0.0.1:11557),
+// errors in the catalogue are returned as *apierrors.Error by calls of the conn.
+// use NewSwapServiceClient to create client of the returned conn.
+func Dial(target string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	opts = append([]grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(unaryClientInterceptor),
+		grpc.WithChainStreamInterceptor(streamClientInterceptor),
+	}, opts...)
+	return grpc.Dial(target, opts...)
+}
+
+// WithAPIKey dial option to send api key in 'x-api-key' metadata
+func WithAPIKey(apiKey string) grpc.DialOption {
+	return grpc.WithPerRPCCredentials(apiKeyCredentials(apiKey))
+}
+
+type apiKeyCredentials string
+
+// GetRequestMetadata impl credentials.PerRPCCredentials
+func (c apiKeyCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{APIKeyMetadataKey: string(c)}, nil
+}
+
+// RequireTransportSecurity impl credentials.PerRPCCredentials
+func (c apiKeyCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+func unaryClientInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	var trailer metadata.MD
+	opts = append(opts, grpc.Trailer(&trailer))
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	return fromStatusError(err, trailer)
+}
+
+func streamClientInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	stream, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		return nil, fromStatusError(err, nil)
+	}
+	return &apiErrorClientStream{stream}, nil
+}
+
+// convert errors of received messages to api errors
+type apiErrorClientStream struct {
+	grpc.ClientStream
+}
+
+func (s *apiErrorClientStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err == nil || err == io.EOF {
+		return err
+	}
+	return fromStatusError(err, s.ClientStream.Trailer())
+}
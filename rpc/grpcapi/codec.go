@@ -0,0 +1,42 @@
+package grpcapi
+
+import (
+	"encoding/json"
+
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// CodecName content subtype of the optional json codec, messages are sent as
+// 'application/grpc+json' if client calls with grpc.CallContentSubtype(CodecName).
+// the default codec is protobuf.
+const CodecName = "json"
+
+// jsonCodec encode grpc messages in json with proto field names
+type jsonCodec struct{}
+
+func init() {
+	encoding.RegisterCodec(jsonCodec{})
+}
+
+// Marshal impl encoding.Codec
+func (jsonCodec) Marshal(v interface{}) ([]byte, error) {
+	if msg, ok := v.(proto.Message); ok {
+		return protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+	}
+	return json.Marshal(v)
+}
+
+// Unmarshal impl encoding.Codec
+func (jsonCodec) Unmarshal(data []byte, v interface{}) error {
+	if msg, ok := v.(proto.Message); ok {
+		return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, msg)
+	}
+	return json.Unmarshal(data, v)
+}
+
+// Name impl encoding.Codec
+func (jsonCodec) Name() string {
+	return CodecName
+}
@@ -0,0 +1,214 @@
+package grpcapi
+
+import (
+	"github.com/anyswap/CrossChain-Bridge/internal/swapapi"
+	"github.com/anyswap/CrossChain-Bridge/rpc/apitypes"
+	"github.com/anyswap/CrossChain-Bridge/tokens"
+)
+
+func uint64Value(p *uint64) uint64 {
+	if p == nil {
+		return 0
+	}
+	return *p
+}
+
+func float64Value(p *float64) float64 {
+	if p == nil {
+		return 0
+	}
+	return *p
+}
+
+// NewServerInfo convert server info of api to grpc message
+func NewServerInfo(info *swapapi.ServerInfo) *ServerInfo {
+	if info == nil {
+		return nil
+	}
+	windows := make([]*MaintenanceWindow, 0, len(info.MaintenanceWindows))
+	for _, window := range info.MaintenanceWindows {
+		windows = append(windows, NewMaintenanceWindow(window))
+	}
+	return &ServerInfo{
+		Identifier:          info.Identifier,
+		MustRegisterAccount: info.MustRegisterAccount,
+		SrcChain:            NewChainConfig(info.SrcChain),
+		DestChain:           NewChainConfig(info.DestChain),
+		Pairids:             info.PairIDs,
+		Version:             info.Version,
+		MaintenanceWindows:  windows,
+	}
+}
+
+// NewChainConfig convert chain config to grpc message
+func NewChainConfig(c *tokens.ChainConfig) *ChainConfig {
+	if c == nil {
+		return nil
+	}
+	return &ChainConfig{
+		BlockChain:              c.BlockChain,
+		NetId:                   c.NetID,
+		Confirmations:           uint64Value(c.Confirmations),
+		InitialHeight:           uint64Value(c.InitialHeight),
+		EnableScan:              c.EnableScan,
+		EnableScanPool:          c.EnableScanPool,
+		ScanReceipt:             c.ScanReceipt,
+		MaxGasPriceFluctPercent: c.MaxGasPriceFluctPercent,
+		WaitTimeToReplace:       c.WaitTimeToReplace,
+		MaxReplaceCount:         int64(c.MaxReplaceCount),
+		EnableReplaceSwap:       c.EnableReplaceSwap,
+		MaxBlockAge:             c.MaxBlockAge,
+		MaxScanLag:              c.MaxScanLag,
+	}
+}
+
+// NewMaintenanceWindow convert maintenance window to grpc message
+func NewMaintenanceWindow(w *tokens.MaintenanceWindow) *MaintenanceWindow {
+	if w == nil {
+		return nil
+	}
+	return &MaintenanceWindow{
+		Id:        w.ID,
+		Pairid:    w.PairID,
+		Direction: w.Direction,
+		StartTime: w.StartTime,
+		EndTime:   w.EndTime,
+		Reason:    w.Reason,
+		CreatedBy: w.CreatedBy,
+	}
+}
+
+// NewTokenPairInfo convert token pair config to grpc message
+func NewTokenPairInfo(c *tokens.TokenPairConfig) *TokenPairInfo {
+	if c == nil {
+		return nil
+	}
+	return &TokenPairInfo{
+		Pairid:    c.PairID,
+		SrcToken:  NewTokenConfig(c.SrcToken),
+		DestToken: NewTokenConfig(c.DestToken),
+	}
+}
+
+// NewTokenConfig convert token config to grpc message
+func NewTokenConfig(c *tokens.TokenConfig) *TokenConfig {
+	if c == nil {
+		return nil
+	}
+	msg := &TokenConfig{
+		Id:                      c.ID,
+		Name:                    c.Name,
+		Symbol:                  c.Symbol,
+		Description:             c.Description,
+		DepositAddress:          c.DepositAddress,
+		DcrmAddress:             c.DcrmAddress,
+		ContractAddress:         c.ContractAddress,
+		ContractCodeHash:        c.ContractCodeHash,
+		MaximumSwap:             float64Value(c.MaximumSwap),
+		MinimumSwap:             float64Value(c.MinimumSwap),
+		BigValueThreshold:       float64Value(c.BigValueThreshold),
+		SwapFeeRate:             float64Value(c.SwapFeeRate),
+		MaximumSwapFee:          float64Value(c.MaximumSwapFee),
+		MinimumSwapFee:          float64Value(c.MinimumSwapFee),
+		PlusGasPricePercentage:  c.PlusGasPricePercentage,
+		DisableSwap:             c.IsSwapDisabled(),
+		IsDelegateContract:      c.IsDelegateContract,
+		DelegateToken:           c.DelegateToken,
+		DefaultGasLimit:         c.DefaultGasLimit,
+		AllowSwapinFromContract: c.AllowSwapinFromContract,
+		RetiringDcrmAddress:     c.RetiringDcrmAddress,
+	}
+	if c.Decimals != nil {
+		msg.Decimals = uint32(*c.Decimals)
+	}
+	if state := c.GetSwapState(); state != nil {
+		msg.SwapState = &SwapState{
+			DisableSwap: state.DisableSwap,
+			Reason:      state.Reason,
+			SetBy:       state.SetBy,
+			Timestamp:   state.Timestamp,
+		}
+	}
+	return msg
+}
+
+// NewSwapStatistics convert swap statistics to grpc message
+func NewSwapStatistics(stat *swapapi.SwapStatistics) *SwapStatistics {
+	if stat == nil {
+		return nil
+	}
+	return &SwapStatistics{
+		Pairid:              stat.PairID,
+		TotalSwapinCount:    int64(stat.TotalSwapinCount),
+		TotalSwapoutCount:   int64(stat.TotalSwapoutCount),
+		PendingSwapinCount:  int64(stat.PendingSwapinCount),
+		PendingSwapoutCount: int64(stat.PendingSwapoutCount),
+		StableSwapinCount:   int64(stat.StableSwapinCount),
+		TotalSwapinValue:    stat.TotalSwapinValue,
+		TotalSwapinFee:      stat.TotalSwapinFee,
+		StableSwapoutCount:  int64(stat.StableSwapoutCount),
+		TotalSwapoutValue:   stat.TotalSwapoutValue,
+		TotalSwapoutFee:     stat.TotalSwapoutFee,
+	}
+}
+
+// NewSwapInfo convert swap info to grpc message
+func NewSwapInfo(swap *apitypes.SwapInfo) *SwapInfo {
+	if swap == nil {
+		return nil
+	}
+	return &SwapInfo{
+		Pairid:        swap.PairID,
+		Txid:          swap.TxID,
+		Txto:          swap.TxTo,
+		Txheight:      swap.TxHeight,
+		Txtime:        swap.TxTime,
+		From:          swap.From,
+		To:            swap.To,
+		Bind:          swap.Bind,
+		Value:         swap.Value,
+		Swaptx:        swap.SwapTx,
+		Swapheight:    swap.SwapHeight,
+		Swaptime:      swap.SwapTime,
+		Swapvalue:     swap.SwapValue,
+		Swaptype:      swap.SwapType,
+		Swapnonce:     swap.SwapNonce,
+		Status:        uint32(swap.Status),
+		Statusmsg:     swap.StatusMsg,
+		Inittime:      swap.InitTime,
+		Timestamp:     swap.Timestamp,
+		Memo:          swap.Memo,
+		Confirmations: swap.Confirmations,
+	}
+}
+
+// NewSwapInfoList convert swap info list to grpc message
+func NewSwapInfoList(swaps []*apitypes.SwapInfo) *SwapInfoList {
+	list := &SwapInfoList{Swaps: make([]*SwapInfo, 0, len(swaps))}
+	for _, swap := range swaps {
+		list.Swaps = append(list.Swaps, NewSwapInfo(swap))
+	}
+	return list
+}
+
+// NewLatestScanInfo convert latest scan info to grpc message
+func NewLatestScanInfo(info *swapapi.LatestScanInfo) *LatestScanInfo {
+	if info == nil {
+		return nil
+	}
+	return &LatestScanInfo{
+		BlockHeight: info.BlockHeight,
+		Timestamp:   info.Timestamp,
+	}
+}
+
+// NewSwapEvent convert swap status push message to grpc message
+func NewSwapEvent(msg *apitypes.SwapEventMessage) *SwapEvent {
+	if msg == nil {
+		return nil
+	}
+	return &SwapEvent{
+		Isswapin: msg.IsSwapin,
+		Swap:     NewSwapInfo(msg.Swap),
+	}
+}
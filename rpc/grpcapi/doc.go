@@ -0,0 +1,10 @@
+// Package grpcapi defines the grpc service of swap server,
+// the messages and service code are generated from swap.proto.
+package grpcapi
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative swap.proto
+
+// FullMethodName get full grpc method name, eg. '/swap.SwapService/Swapin'
+func FullMethodName(method string) string {
+	return "/" + SwapService_ServiceDesc.ServiceName + "/" + method
+}
@@ -0,0 +1,85 @@
+package grpcapi
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/anyswap/CrossChain-Bridge/rpc/apierrors"
+)
+
+// trailer keys carrying the api error in the catalogue
+const (
+	errorCodeKey = "api-error-code"
+	errorNameKey = "api-error-name"
+)
+
+func toGRPCCode(httpStatus int) codes.Code {
+	switch httpStatus {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.FailedPrecondition
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusNotImplemented:
+		return codes.Unimplemented
+	case http.StatusBadGateway, http.StatusServiceUnavailable:
+		return codes.Unavailable
+	default:
+		return codes.Internal
+	}
+}
+
+// ToStatusError convert error to grpc status error,
+// and set the api error code and name in trailer of ctx (or stream if not nil).
+func ToStatusError(ctx context.Context, stream grpc.ServerStream, err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	apiErr := apierrors.Convert(err)
+	trailer := metadata.Pairs(
+		errorCodeKey, strconv.Itoa(apiErr.Code),
+		errorNameKey, apiErr.Name,
+	)
+	if stream != nil {
+		stream.SetTrailer(trailer)
+	} else {
+		_ = grpc.SetTrailer(ctx, trailer)
+	}
+	return status.Error(toGRPCCode(apiErr.HTTPStatus()), apiErr.Message)
+}
+
+// fromStatusError convert grpc status error to api error if the trailer has api error code
+func fromStatusError(err error, trailer metadata.MD) error {
+	if err == nil {
+		return nil
+	}
+	codeValues := trailer.Get(errorCodeKey)
+	if len(codeValues) == 0 {
+		return err
+	}
+	code, errf := strconv.Atoi(codeValues[0])
+	if errf != nil {
+		return err
+	}
+	var data map[string]interface{}
+	if names := trailer.Get(errorNameKey); len(names) != 0 {
+		data = map[string]interface{}{"name": names[0]}
+	}
+	return apierrors.FromRPCError(code, status.Convert(err).Message(), data)
+}
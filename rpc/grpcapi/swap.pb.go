@@ -0,0 +1,2197 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.15.8
+// source: swap.proto
+
+package grpcapi
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Empty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swap_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_swap_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_swap_proto_rawDescGZIP(), []int{0}
+}
+
+type PairIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pairid string `protobuf:"bytes,1,opt,name=pairid,proto3" json:"pairid,omitempty"`
+}
+
+func (x *PairIDRequest) Reset() {
+	*x = PairIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swap_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PairIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairIDRequest) ProtoMessage() {}
+
+func (x *PairIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_swap_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairIDRequest.ProtoReflect.Descriptor instead.
+func (*PairIDRequest) Descriptor() ([]byte, []int) {
+	return file_swap_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PairIDRequest) GetPairid() string {
+	if x != nil {
+		return x.Pairid
+	}
+	return ""
+}
+
+type TxAndPairIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Txid   string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	Pairid string `protobuf:"bytes,2,opt,name=pairid,proto3" json:"pairid,omitempty"`
+	Bind   string `protobuf:"bytes,3,opt,name=bind,proto3" json:"bind,omitempty"`
+}
+
+func (x *TxAndPairIDRequest) Reset() {
+	*x = TxAndPairIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swap_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxAndPairIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxAndPairIDRequest) ProtoMessage() {}
+
+func (x *TxAndPairIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_swap_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxAndPairIDRequest.ProtoReflect.Descriptor instead.
+func (*TxAndPairIDRequest) Descriptor() ([]byte, []int) {
+	return file_swap_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TxAndPairIDRequest) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+func (x *TxAndPairIDRequest) GetPairid() string {
+	if x != nil {
+		return x.Pairid
+	}
+	return ""
+}
+
+func (x *TxAndPairIDRequest) GetBind() string {
+	if x != nil {
+		return x.Bind
+	}
+	return ""
+}
+
+type QueryHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Pairid  string `protobuf:"bytes,2,opt,name=pairid,proto3" json:"pairid,omitempty"`
+	Offset  int64  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit   int64  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *QueryHistoryRequest) Reset() {
+	*x = QueryHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swap_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryHistoryRequest) ProtoMessage() {}
+
+func (x *QueryHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_swap_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryHistoryRequest.ProtoReflect.Descriptor instead.
+func (*QueryHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_swap_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueryHistoryRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *QueryHistoryRequest) GetPairid() string {
+	if x != nil {
+		return x.Pairid
+	}
+	return ""
+}
+
+func (x *QueryHistoryRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *QueryHistoryRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type LatestScanInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Issrc bool `protobuf:"varint,1,opt,name=issrc,proto3" json:"issrc,omitempty"`
+}
+
+func (x *LatestScanInfoRequest) Reset() {
+	*x = LatestScanInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swap_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LatestScanInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LatestScanInfoRequest) ProtoMessage() {}
+
+func (x *LatestScanInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_swap_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LatestScanInfoRequest.ProtoReflect.Descriptor instead.
+func (*LatestScanInfoRequest) Descriptor() ([]byte, []int) {
+	return file_swap_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LatestScanInfoRequest) GetIssrc() bool {
+	if x != nil {
+		return x.Issrc
+	}
+	return false
+}
+
+// swap matches if all of the specified fields match,
+// and a field matches if any of its values match.
+type WatchSwapsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Txids   []string `protobuf:"bytes,1,rep,name=txids,proto3" json:"txids,omitempty"`
+	Binds   []string `protobuf:"bytes,2,rep,name=binds,proto3" json:"binds,omitempty"`
+	Pairids []string `protobuf:"bytes,3,rep,name=pairids,proto3" json:"pairids,omitempty"`
+}
+
+func (x *WatchSwapsRequest) Reset() {
+	*x = WatchSwapsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swap_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchSwapsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchSwapsRequest) ProtoMessage() {}
+
+func (x *WatchSwapsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_swap_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchSwapsRequest.ProtoReflect.Descriptor instead.
+func (*WatchSwapsRequest) Descriptor() ([]byte, []int) {
+	return file_swap_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *WatchSwapsRequest) GetTxids() []string {
+	if x != nil {
+		return x.Txids
+	}
+	return nil
+}
+
+func (x *WatchSwapsRequest) GetBinds() []string {
+	if x != nil {
+		return x.Binds
+	}
+	return nil
+}
+
+func (x *WatchSwapsRequest) GetPairids() []string {
+	if x != nil {
+		return x.Pairids
+	}
+	return nil
+}
+
+type ServerInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Identifier          string               `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	MustRegisterAccount bool                 `protobuf:"varint,2,opt,name=must_register_account,json=mustRegisterAccount,proto3" json:"must_register_account,omitempty"`
+	SrcChain            *ChainConfig         `protobuf:"bytes,3,opt,name=src_chain,json=srcChain,proto3" json:"src_chain,omitempty"`
+	DestChain           *ChainConfig         `protobuf:"bytes,4,opt,name=dest_chain,json=destChain,proto3" json:"dest_chain,omitempty"`
+	Pairids             []string             `protobuf:"bytes,5,rep,name=pairids,proto3" json:"pairids,omitempty"`
+	Version             string               `protobuf:"bytes,6,opt,name=version,proto3" json:"version,omitempty"`
+	MaintenanceWindows  []*MaintenanceWindow `protobuf:"bytes,7,rep,name=maintenance_windows,json=maintenanceWindows,proto3" json:"maintenance_windows,omitempty"`
+}
+
+func (x *ServerInfo) Reset() {
+	*x = ServerInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swap_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerInfo) ProtoMessage() {}
+
+func (x *ServerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_swap_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerInfo.ProtoReflect.Descriptor instead.
+func (*ServerInfo) Descriptor() ([]byte, []int) {
+	return file_swap_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ServerInfo) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+func (x *ServerInfo) GetMustRegisterAccount() bool {
+	if x != nil {
+		return x.MustRegisterAccount
+	}
+	return false
+}
+
+func (x *ServerInfo) GetSrcChain() *ChainConfig {
+	if x != nil {
+		return x.SrcChain
+	}
+	return nil
+}
+
+func (x *ServerInfo) GetDestChain() *ChainConfig {
+	if x != nil {
+		return x.DestChain
+	}
+	return nil
+}
+
+func (x *ServerInfo) GetPairids() []string {
+	if x != nil {
+		return x.Pairids
+	}
+	return nil
+}
+
+func (x *ServerInfo) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ServerInfo) GetMaintenanceWindows() []*MaintenanceWindow {
+	if x != nil {
+		return x.MaintenanceWindows
+	}
+	return nil
+}
+
+type ChainConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockChain              string `protobuf:"bytes,1,opt,name=block_chain,json=blockChain,proto3" json:"block_chain,omitempty"`
+	NetId                   string `protobuf:"bytes,2,opt,name=net_id,json=netId,proto3" json:"net_id,omitempty"`
+	Confirmations           uint64 `protobuf:"varint,3,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	InitialHeight           uint64 `protobuf:"varint,4,opt,name=initial_height,json=initialHeight,proto3" json:"initial_height,omitempty"`
+	EnableScan              bool   `protobuf:"varint,5,opt,name=enable_scan,json=enableScan,proto3" json:"enable_scan,omitempty"`
+	EnableScanPool          bool   `protobuf:"varint,6,opt,name=enable_scan_pool,json=enableScanPool,proto3" json:"enable_scan_pool,omitempty"`
+	ScanReceipt             bool   `protobuf:"varint,7,opt,name=scan_receipt,json=scanReceipt,proto3" json:"scan_receipt,omitempty"`
+	MaxGasPriceFluctPercent uint64 `protobuf:"varint,8,opt,name=max_gas_price_fluct_percent,json=maxGasPriceFluctPercent,proto3" json:"max_gas_price_fluct_percent,omitempty"`
+	WaitTimeToReplace       int64  `protobuf:"varint,9,opt,name=wait_time_to_replace,json=waitTimeToReplace,proto3" json:"wait_time_to_replace,omitempty"` // seconds
+	MaxReplaceCount         int64  `protobuf:"varint,10,opt,name=max_replace_count,json=maxReplaceCount,proto3" json:"max_replace_count,omitempty"`
+	EnableReplaceSwap       bool   `protobuf:"varint,11,opt,name=enable_replace_swap,json=enableReplaceSwap,proto3" json:"enable_replace_swap,omitempty"`
+	MaxBlockAge             int64  `protobuf:"varint,12,opt,name=max_block_age,json=maxBlockAge,proto3" json:"max_block_age,omitempty"` // seconds
+	MaxScanLag              uint64 `protobuf:"varint,13,opt,name=max_scan_lag,json=maxScanLag,proto3" json:"max_scan_lag,omitempty"`    // blocks
+}
+
+func (x *ChainConfig) Reset() {
+	*x = ChainConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swap_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChainConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainConfig) ProtoMessage() {}
+
+func (x *ChainConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_swap_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainConfig.ProtoReflect.Descriptor instead.
+func (*ChainConfig) Descriptor() ([]byte, []int) {
+	return file_swap_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ChainConfig) GetBlockChain() string {
+	if x != nil {
+		return x.BlockChain
+	}
+	return ""
+}
+
+func (x *ChainConfig) GetNetId() string {
+	if x != nil {
+		return x.NetId
+	}
+	return ""
+}
+
+func (x *ChainConfig) GetConfirmations() uint64 {
+	if x != nil {
+		return x.Confirmations
+	}
+	return 0
+}
+
+func (x *ChainConfig) GetInitialHeight() uint64 {
+	if x != nil {
+		return x.InitialHeight
+	}
+	return 0
+}
+
+func (x *ChainConfig) GetEnableScan() bool {
+	if x != nil {
+		return x.EnableScan
+	}
+	return false
+}
+
+func (x *ChainConfig) GetEnableScanPool() bool {
+	if x != nil {
+		return x.EnableScanPool
+	}
+	return false
+}
+
+func (x *ChainConfig) GetScanReceipt() bool {
+	if x != nil {
+		return x.ScanReceipt
+	}
+	return false
+}
+
+func (x *ChainConfig) GetMaxGasPriceFluctPercent() uint64 {
+	if x != nil {
+		return x.MaxGasPriceFluctPercent
+	}
+	return 0
+}
+
+func (x *ChainConfig) GetWaitTimeToReplace() int64 {
+	if x != nil {
+		return x.WaitTimeToReplace
+	}
+	return 0
+}
+
+func (x *ChainConfig) GetMaxReplaceCount() int64 {
+	if x != nil {
+		return x.MaxReplaceCount
+	}
+	return 0
+}
+
+func (x *ChainConfig) GetEnableReplaceSwap() bool {
+	if x != nil {
+		return x.EnableReplaceSwap
+	}
+	return false
+}
+
+func (x *ChainConfig) GetMaxBlockAge() int64 {
+	if x != nil {
+		return x.MaxBlockAge
+	}
+	return 0
+}
+
+func (x *ChainConfig) GetMaxScanLag() uint64 {
+	if x != nil {
+		return x.MaxScanLag
+	}
+	return 0
+}
+
+type MaintenanceWindow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pairid    string `protobuf:"bytes,2,opt,name=pairid,proto3" json:"pairid,omitempty"`
+	Direction string `protobuf:"bytes,3,opt,name=direction,proto3" json:"direction,omitempty"`                   // deposit, withdraw or both
+	StartTime int64  `protobuf:"varint,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // unix seconds, inclusive
+	EndTime   int64  `protobuf:"varint,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`       // unix seconds, exclusive
+	Reason    string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedBy string `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+}
+
+func (x *MaintenanceWindow) Reset() {
+	*x = MaintenanceWindow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swap_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaintenanceWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceWindow) ProtoMessage() {}
+
+func (x *MaintenanceWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_swap_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceWindow.ProtoReflect.Descriptor instead.
+func (*MaintenanceWindow) Descriptor() ([]byte, []int) {
+	return file_swap_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MaintenanceWindow) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetPairid() string {
+	if x != nil {
+		return x.Pairid
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *MaintenanceWindow) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *MaintenanceWindow) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type TokenPairInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pairid    string       `protobuf:"bytes,1,opt,name=pairid,proto3" json:"pairid,omitempty"`
+	SrcToken  *TokenConfig `protobuf:"bytes,2,opt,name=src_token,json=srcToken,proto3" json:"src_token,omitempty"`
+	DestToken *TokenConfig `protobuf:"bytes,3,opt,name=dest_token,json=destToken,proto3" json:"dest_token,omitempty"`
+}
+
+func (x *TokenPairInfo) Reset() {
+	*x = TokenPairInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swap_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenPairInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenPairInfo) ProtoMessage() {}
+
+func (x *TokenPairInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_swap_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenPairInfo.ProtoReflect.Descriptor instead.
+func (*TokenPairInfo) Descriptor() ([]byte, []int) {
+	return file_swap_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TokenPairInfo) GetPairid() string {
+	if x != nil {
+		return x.Pairid
+	}
+	return ""
+}
+
+func (x *TokenPairInfo) GetSrcToken() *TokenConfig {
+	if x != nil {
+		return x.SrcToken
+	}
+	return nil
+}
+
+func (x *TokenPairInfo) GetDestToken() *TokenConfig {
+	if x != nil {
+		return x.DestToken
+	}
+	return nil
+}
+
+// swap amounts are in whole unit (eg. BTC, ETH, FSN), not Satoshi
+type TokenConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                      string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                    string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol                  string     `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Decimals                uint32     `protobuf:"varint,4,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	Description             string     `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	DepositAddress          string     `protobuf:"bytes,6,opt,name=deposit_address,json=depositAddress,proto3" json:"deposit_address,omitempty"`
+	DcrmAddress             string     `protobuf:"bytes,7,opt,name=dcrm_address,json=dcrmAddress,proto3" json:"dcrm_address,omitempty"`
+	ContractAddress         string     `protobuf:"bytes,8,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	ContractCodeHash        string     `protobuf:"bytes,9,opt,name=contract_code_hash,json=contractCodeHash,proto3" json:"contract_code_hash,omitempty"`
+	MaximumSwap             float64    `protobuf:"fixed64,10,opt,name=maximum_swap,json=maximumSwap,proto3" json:"maximum_swap,omitempty"`
+	MinimumSwap             float64    `protobuf:"fixed64,11,opt,name=minimum_swap,json=minimumSwap,proto3" json:"minimum_swap,omitempty"`
+	BigValueThreshold       float64    `protobuf:"fixed64,12,opt,name=big_value_threshold,json=bigValueThreshold,proto3" json:"big_value_threshold,omitempty"`
+	SwapFeeRate             float64    `protobuf:"fixed64,13,opt,name=swap_fee_rate,json=swapFeeRate,proto3" json:"swap_fee_rate,omitempty"`
+	MaximumSwapFee          float64    `protobuf:"fixed64,14,opt,name=maximum_swap_fee,json=maximumSwapFee,proto3" json:"maximum_swap_fee,omitempty"`
+	MinimumSwapFee          float64    `protobuf:"fixed64,15,opt,name=minimum_swap_fee,json=minimumSwapFee,proto3" json:"minimum_swap_fee,omitempty"`
+	PlusGasPricePercentage  uint64     `protobuf:"varint,16,opt,name=plus_gas_price_percentage,json=plusGasPricePercentage,proto3" json:"plus_gas_price_percentage,omitempty"`
+	DisableSwap             bool       `protobuf:"varint,17,opt,name=disable_swap,json=disableSwap,proto3" json:"disable_swap,omitempty"`
+	IsDelegateContract      bool       `protobuf:"varint,18,opt,name=is_delegate_contract,json=isDelegateContract,proto3" json:"is_delegate_contract,omitempty"`
+	DelegateToken           string     `protobuf:"bytes,19,opt,name=delegate_token,json=delegateToken,proto3" json:"delegate_token,omitempty"`
+	DefaultGasLimit         uint64     `protobuf:"varint,20,opt,name=default_gas_limit,json=defaultGasLimit,proto3" json:"default_gas_limit,omitempty"`
+	AllowSwapinFromContract bool       `protobuf:"varint,21,opt,name=allow_swapin_from_contract,json=allowSwapinFromContract,proto3" json:"allow_swapin_from_contract,omitempty"`
+	RetiringDcrmAddress     string     `protobuf:"bytes,22,opt,name=retiring_dcrm_address,json=retiringDcrmAddress,proto3" json:"retiring_dcrm_address,omitempty"`
+	SwapState               *SwapState `protobuf:"bytes,23,opt,name=swap_state,json=swapState,proto3" json:"swap_state,omitempty"`
+}
+
+func (x *TokenConfig) Reset() {
+	*x = TokenConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swap_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenConfig) ProtoMessage() {}
+
+func (x *TokenConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_swap_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenConfig.ProtoReflect.Descriptor instead.
+func (*TokenConfig) Descriptor() ([]byte, []int) {
+	return file_swap_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TokenConfig) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TokenConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TokenConfig) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *TokenConfig) GetDecimals() uint32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+func (x *TokenConfig) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TokenConfig) GetDepositAddress() string {
+	if x != nil {
+		return x.DepositAddress
+	}
+	return ""
+}
+
+func (x *TokenConfig) GetDcrmAddress() string {
+	if x != nil {
+		return x.DcrmAddress
+	}
+	return ""
+}
+
+func (x *TokenConfig) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *TokenConfig) GetContractCodeHash() string {
+	if x != nil {
+		return x.ContractCodeHash
+	}
+	return ""
+}
+
+func (x *TokenConfig) GetMaximumSwap() float64 {
+	if x != nil {
+		return x.MaximumSwap
+	}
+	return 0
+}
+
+func (x *TokenConfig) GetMinimumSwap() float64 {
+	if x != nil {
+		return x.MinimumSwap
+	}
+	return 0
+}
+
+func (x *TokenConfig) GetBigValueThreshold() float64 {
+	if x != nil {
+		return x.BigValueThreshold
+	}
+	return 0
+}
+
+func (x *TokenConfig) GetSwapFeeRate() float64 {
+	if x != nil {
+		return x.SwapFeeRate
+	}
+	return 0
+}
+
+func (x *TokenConfig) GetMaximumSwapFee() float64 {
+	if x != nil {
+		return x.MaximumSwapFee
+	}
+	return 0
+}
+
+func (x *TokenConfig) GetMinimumSwapFee() float64 {
+	if x != nil {
+		return x.MinimumSwapFee
+	}
+	return 0
+}
+
+func (x *TokenConfig) GetPlusGasPricePercentage() uint64 {
+	if x != nil {
+		return x.PlusGasPricePercentage
+	}
+	return 0
+}
+
+func (x *TokenConfig) GetDisableSwap() bool {
+	if x != nil {
+		return x.DisableSwap
+	}
+	return false
+}
+
+func (x *TokenConfig) GetIsDelegateContract() bool {
+	if x != nil {
+		return x.IsDelegateContract
+	}
+	return false
+}
+
+func (x *TokenConfig) GetDelegateToken() string {
+	if x != nil {
+		return x.DelegateToken
+	}
+	return ""
+}
+
+func (x *TokenConfig) GetDefaultGasLimit() uint64 {
+	if x != nil {
+		return x.DefaultGasLimit
+	}
+	return 0
+}
+
+func (x *TokenConfig) GetAllowSwapinFromContract() bool {
+	if x != nil {
+		return x.AllowSwapinFromContract
+	}
+	return false
+}
+
+func (x *TokenConfig) GetRetiringDcrmAddress() string {
+	if x != nil {
+		return x.RetiringDcrmAddress
+	}
+	return ""
+}
+
+func (x *TokenConfig) GetSwapState() *SwapState {
+	if x != nil {
+		return x.SwapState
+	}
+	return nil
+}
+
+// runtime swap state set by admin
+type SwapState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DisableSwap bool   `protobuf:"varint,1,opt,name=disable_swap,json=disableSwap,proto3" json:"disable_swap,omitempty"`
+	Reason      string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	SetBy       string `protobuf:"bytes,3,opt,name=set_by,json=setBy,proto3" json:"set_by,omitempty"`
+	Timestamp   int64  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *SwapState) Reset() {
+	*x = SwapState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swap_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapState) ProtoMessage() {}
+
+func (x *SwapState) ProtoReflect() protoreflect.Message {
+	mi := &file_swap_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapState.ProtoReflect.Descriptor instead.
+func (*SwapState) Descriptor() ([]byte, []int) {
+	return file_swap_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SwapState) GetDisableSwap() bool {
+	if x != nil {
+		return x.DisableSwap
+	}
+	return false
+}
+
+func (x *SwapState) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SwapState) GetSetBy() string {
+	if x != nil {
+		return x.SetBy
+	}
+	return ""
+}
+
+func (x *SwapState) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type SwapStatistics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pairid              string `protobuf:"bytes,1,opt,name=pairid,proto3" json:"pairid,omitempty"`
+	TotalSwapinCount    int64  `protobuf:"varint,2,opt,name=total_swapin_count,json=totalSwapinCount,proto3" json:"total_swapin_count,omitempty"`
+	TotalSwapoutCount   int64  `protobuf:"varint,3,opt,name=total_swapout_count,json=totalSwapoutCount,proto3" json:"total_swapout_count,omitempty"`
+	PendingSwapinCount  int64  `protobuf:"varint,4,opt,name=pending_swapin_count,json=pendingSwapinCount,proto3" json:"pending_swapin_count,omitempty"`
+	PendingSwapoutCount int64  `protobuf:"varint,5,opt,name=pending_swapout_count,json=pendingSwapoutCount,proto3" json:"pending_swapout_count,omitempty"`
+	StableSwapinCount   int64  `protobuf:"varint,6,opt,name=stable_swapin_count,json=stableSwapinCount,proto3" json:"stable_swapin_count,omitempty"`
+	TotalSwapinValue    string `protobuf:"bytes,7,opt,name=total_swapin_value,json=totalSwapinValue,proto3" json:"total_swapin_value,omitempty"`
+	TotalSwapinFee      string `protobuf:"bytes,8,opt,name=total_swapin_fee,json=totalSwapinFee,proto3" json:"total_swapin_fee,omitempty"`
+	StableSwapoutCount  int64  `protobuf:"varint,9,opt,name=stable_swapout_count,json=stableSwapoutCount,proto3" json:"stable_swapout_count,omitempty"`
+	TotalSwapoutValue   string `protobuf:"bytes,10,opt,name=total_swapout_value,json=totalSwapoutValue,proto3" json:"total_swapout_value,omitempty"`
+	TotalSwapoutFee     string `protobuf:"bytes,11,opt,name=total_swapout_fee,json=totalSwapoutFee,proto3" json:"total_swapout_fee,omitempty"`
+}
+
+func (x *SwapStatistics) Reset() {
+	*x = SwapStatistics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swap_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapStatistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapStatistics) ProtoMessage() {}
+
+func (x *SwapStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_swap_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapStatistics.ProtoReflect.Descriptor instead.
+func (*SwapStatistics) Descriptor() ([]byte, []int) {
+	return file_swap_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SwapStatistics) GetPairid() string {
+	if x != nil {
+		return x.Pairid
+	}
+	return ""
+}
+
+func (x *SwapStatistics) GetTotalSwapinCount() int64 {
+	if x != nil {
+		return x.TotalSwapinCount
+	}
+	return 0
+}
+
+func (x *SwapStatistics) GetTotalSwapoutCount() int64 {
+	if x != nil {
+		return x.TotalSwapoutCount
+	}
+	return 0
+}
+
+func (x *SwapStatistics) GetPendingSwapinCount() int64 {
+	if x != nil {
+		return x.PendingSwapinCount
+	}
+	return 0
+}
+
+func (x *SwapStatistics) GetPendingSwapoutCount() int64 {
+	if x != nil {
+		return x.PendingSwapoutCount
+	}
+	return 0
+}
+
+func (x *SwapStatistics) GetStableSwapinCount() int64 {
+	if x != nil {
+		return x.StableSwapinCount
+	}
+	return 0
+}
+
+func (x *SwapStatistics) GetTotalSwapinValue() string {
+	if x != nil {
+		return x.TotalSwapinValue
+	}
+	return ""
+}
+
+func (x *SwapStatistics) GetTotalSwapinFee() string {
+	if x != nil {
+		return x.TotalSwapinFee
+	}
+	return ""
+}
+
+func (x *SwapStatistics) GetStableSwapoutCount() int64 {
+	if x != nil {
+		return x.StableSwapoutCount
+	}
+	return 0
+}
+
+func (x *SwapStatistics) GetTotalSwapoutValue() string {
+	if x != nil {
+		return x.TotalSwapoutValue
+	}
+	return ""
+}
+
+func (x *SwapStatistics) GetTotalSwapoutFee() string {
+	if x != nil {
+		return x.TotalSwapoutFee
+	}
+	return ""
+}
+
+type SwapInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pairid        string `protobuf:"bytes,1,opt,name=pairid,proto3" json:"pairid,omitempty"`
+	Txid          string `protobuf:"bytes,2,opt,name=txid,proto3" json:"txid,omitempty"`
+	Txto          string `protobuf:"bytes,3,opt,name=txto,proto3" json:"txto,omitempty"`
+	Txheight      uint64 `protobuf:"varint,4,opt,name=txheight,proto3" json:"txheight,omitempty"`
+	Txtime        uint64 `protobuf:"varint,5,opt,name=txtime,proto3" json:"txtime,omitempty"`
+	From          string `protobuf:"bytes,6,opt,name=from,proto3" json:"from,omitempty"`
+	To            string `protobuf:"bytes,7,opt,name=to,proto3" json:"to,omitempty"`
+	Bind          string `protobuf:"bytes,8,opt,name=bind,proto3" json:"bind,omitempty"`
+	Value         string `protobuf:"bytes,9,opt,name=value,proto3" json:"value,omitempty"`
+	Swaptx        string `protobuf:"bytes,10,opt,name=swaptx,proto3" json:"swaptx,omitempty"`
+	Swapheight    uint64 `protobuf:"varint,11,opt,name=swapheight,proto3" json:"swapheight,omitempty"`
+	Swaptime      uint64 `protobuf:"varint,12,opt,name=swaptime,proto3" json:"swaptime,omitempty"`
+	Swapvalue     string `protobuf:"bytes,13,opt,name=swapvalue,proto3" json:"swapvalue,omitempty"`
+	Swaptype      uint32 `protobuf:"varint,14,opt,name=swaptype,proto3" json:"swaptype,omitempty"`
+	Swapnonce     uint64 `protobuf:"varint,15,opt,name=swapnonce,proto3" json:"swapnonce,omitempty"`
+	Status        uint32 `protobuf:"varint,16,opt,name=status,proto3" json:"status,omitempty"`
+	Statusmsg     string `protobuf:"bytes,17,opt,name=statusmsg,proto3" json:"statusmsg,omitempty"`
+	Inittime      int64  `protobuf:"varint,18,opt,name=inittime,proto3" json:"inittime,omitempty"`
+	Timestamp     int64  `protobuf:"varint,19,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Memo          string `protobuf:"bytes,20,opt,name=memo,proto3" json:"memo,omitempty"`
+	Confirmations uint64 `protobuf:"varint,21,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+}
+
+func (x *SwapInfo) Reset() {
+	*x = SwapInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swap_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapInfo) ProtoMessage() {}
+
+func (x *SwapInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_swap_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapInfo.ProtoReflect.Descriptor instead.
+func (*SwapInfo) Descriptor() ([]byte, []int) {
+	return file_swap_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SwapInfo) GetPairid() string {
+	if x != nil {
+		return x.Pairid
+	}
+	return ""
+}
+
+func (x *SwapInfo) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+func (x *SwapInfo) GetTxto() string {
+	if x != nil {
+		return x.Txto
+	}
+	return ""
+}
+
+func (x *SwapInfo) GetTxheight() uint64 {
+	if x != nil {
+		return x.Txheight
+	}
+	return 0
+}
+
+func (x *SwapInfo) GetTxtime() uint64 {
+	if x != nil {
+		return x.Txtime
+	}
+	return 0
+}
+
+func (x *SwapInfo) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *SwapInfo) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *SwapInfo) GetBind() string {
+	if x != nil {
+		return x.Bind
+	}
+	return ""
+}
+
+func (x *SwapInfo) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *SwapInfo) GetSwaptx() string {
+	if x != nil {
+		return x.Swaptx
+	}
+	return ""
+}
+
+func (x *SwapInfo) GetSwapheight() uint64 {
+	if x != nil {
+		return x.Swapheight
+	}
+	return 0
+}
+
+func (x *SwapInfo) GetSwaptime() uint64 {
+	if x != nil {
+		return x.Swaptime
+	}
+	return 0
+}
+
+func (x *SwapInfo) GetSwapvalue() string {
+	if x != nil {
+		return x.Swapvalue
+	}
+	return ""
+}
+
+func (x *SwapInfo) GetSwaptype() uint32 {
+	if x != nil {
+		return x.Swaptype
+	}
+	return 0
+}
+
+func (x *SwapInfo) GetSwapnonce() uint64 {
+	if x != nil {
+		return x.Swapnonce
+	}
+	return 0
+}
+
+func (x *SwapInfo) GetStatus() uint32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *SwapInfo) GetStatusmsg() string {
+	if x != nil {
+		return x.Statusmsg
+	}
+	return ""
+}
+
+func (x *SwapInfo) GetInittime() int64 {
+	if x != nil {
+		return x.Inittime
+	}
+	return 0
+}
+
+func (x *SwapInfo) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *SwapInfo) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *SwapInfo) GetConfirmations() uint64 {
+	if x != nil {
+		return x.Confirmations
+	}
+	return 0
+}
+
+type SwapInfoList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Swaps []*SwapInfo `protobuf:"bytes,1,rep,name=swaps,proto3" json:"swaps,omitempty"`
+}
+
+func (x *SwapInfoList) Reset() {
+	*x = SwapInfoList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swap_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapInfoList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapInfoList) ProtoMessage() {}
+
+func (x *SwapInfoList) ProtoReflect() protoreflect.Message {
+	mi := &file_swap_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapInfoList.ProtoReflect.Descriptor instead.
+func (*SwapInfoList) Descriptor() ([]byte, []int) {
+	return file_swap_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SwapInfoList) GetSwaps() []*SwapInfo {
+	if x != nil {
+		return x.Swaps
+	}
+	return nil
+}
+
+// result is 'Success', or 'Queued' if registered during maintenance window
+type PostReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *PostReply) Reset() {
+	*x = PostReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swap_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostReply) ProtoMessage() {}
+
+func (x *PostReply) ProtoReflect() protoreflect.Message {
+	mi := &file_swap_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostReply.ProtoReflect.Descriptor instead.
+func (*PostReply) Descriptor() ([]byte, []int) {
+	return file_swap_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PostReply) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+type LatestScanInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockHeight uint64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Timestamp   int64  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *LatestScanInfo) Reset() {
+	*x = LatestScanInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swap_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LatestScanInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LatestScanInfo) ProtoMessage() {}
+
+func (x *LatestScanInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_swap_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LatestScanInfo.ProtoReflect.Descriptor instead.
+func (*LatestScanInfo) Descriptor() ([]byte, []int) {
+	return file_swap_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *LatestScanInfo) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *LatestScanInfo) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type SwapEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Isswapin bool      `protobuf:"varint,1,opt,name=isswapin,proto3" json:"isswapin,omitempty"`
+	Swap     *SwapInfo `protobuf:"bytes,2,opt,name=swap,proto3" json:"swap,omitempty"`
+}
+
+func (x *SwapEvent) Reset() {
+	*x = SwapEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swap_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapEvent) ProtoMessage() {}
+
+func (x *SwapEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_swap_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapEvent.ProtoReflect.Descriptor instead.
+func (*SwapEvent) Descriptor() ([]byte, []int) {
+	return file_swap_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SwapEvent) GetIsswapin() bool {
+	if x != nil {
+		return x.Isswapin
+	}
+	return false
+}
+
+func (x *SwapEvent) GetSwap() *SwapInfo {
+	if x != nil {
+		return x.Swap
+	}
+	return nil
+}
+
+var File_swap_proto protoreflect.FileDescriptor
+
+var file_swap_proto_rawDesc = []byte{
+	0x0a, 0x0a, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x73, 0x77,
+	0x61, 0x70, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x27, 0x0a, 0x0d, 0x50,
+	0x61, 0x69, 0x72, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x61, 0x69, 0x72, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61,
+	0x69, 0x72, 0x69, 0x64, 0x22, 0x54, 0x0a, 0x12, 0x54, 0x78, 0x41, 0x6e, 0x64, 0x50, 0x61, 0x69,
+	0x72, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x61, 0x69, 0x72, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x61, 0x69, 0x72, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x69, 0x6e, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x69, 0x6e, 0x64, 0x22, 0x75, 0x0a, 0x13, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x61, 0x69, 0x72, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x69,
+	0x72, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x22, 0x2d, 0x0a, 0x15, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x63, 0x61, 0x6e, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x73,
+	0x73, 0x72, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x73, 0x72, 0x63,
+	0x22, 0x59, 0x0a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x78, 0x69, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x78, 0x69, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62,
+	0x69, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x62, 0x69, 0x6e, 0x64,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x69, 0x72, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x69, 0x72, 0x69, 0x64, 0x73, 0x22, 0xc0, 0x02, 0x0a, 0x0a,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x15, 0x6d, 0x75,
+	0x73, 0x74, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x6d, 0x75, 0x73, 0x74, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e,
+	0x0a, 0x09, 0x73, 0x72, 0x63, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x08, 0x73, 0x72, 0x63, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x30,
+	0x0a, 0x0a, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x64, 0x65, 0x73, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x69, 0x72, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x70, 0x61, 0x69, 0x72, 0x69, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x48, 0x0a, 0x13, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x12, 0x6d, 0x61, 0x69, 0x6e,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x22, 0x91,
+	0x04, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1f,
+	0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12,
+	0x15, 0x0a, 0x06, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x63,
+	0x61, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x53, 0x63, 0x61, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73,
+	0x63, 0x61, 0x6e, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x63, 0x61, 0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x21,
+	0x0a, 0x0c, 0x73, 0x63, 0x61, 0x6e, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x12, 0x3c, 0x0a, 0x1b, 0x6d, 0x61, 0x78, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x5f, 0x66, 0x6c, 0x75, 0x63, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x6d, 0x61, 0x78, 0x47, 0x61, 0x73, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x46, 0x6c, 0x75, 0x63, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12,
+	0x2f, 0x0a, 0x14, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x5f,
+	0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x77,
+	0x61, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d, 0x61, 0x78,
+	0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x73,
+	0x77, 0x61, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x77, 0x61, 0x70, 0x12, 0x22, 0x0a, 0x0d,
+	0x6d, 0x61, 0x78, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x41, 0x67, 0x65,
+	0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63, 0x61, 0x6e, 0x5f, 0x6c, 0x61, 0x67,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x61, 0x6e, 0x4c,
+	0x61, 0x67, 0x22, 0xca, 0x01, 0x0a, 0x11, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x69, 0x72,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x69, 0x72, 0x69, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x22,
+	0x89, 0x01, 0x0a, 0x0d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x69, 0x72, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x61, 0x69, 0x72, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x09, 0x73, 0x72, 0x63,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73,
+	0x77, 0x61, 0x70, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x08, 0x73, 0x72, 0x63, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x30, 0x0a, 0x0a, 0x64, 0x65, 0x73,
+	0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x73, 0x77, 0x61, 0x70, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x09, 0x64, 0x65, 0x73, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x9e, 0x07, 0x0a, 0x0b,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d,
+	0x61, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d,
+	0x61, 0x6c, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x64, 0x63, 0x72, 0x6d, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x63, 0x72, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x12,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61,
+	0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0b, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x53, 0x77, 0x61, 0x70, 0x12, 0x21, 0x0a,
+	0x0c, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x53, 0x77, 0x61, 0x70,
+	0x12, 0x2e, 0x0a, 0x13, 0x62, 0x69, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x62,
+	0x69, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x12, 0x22, 0x0a, 0x0d, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x73, 0x77, 0x61, 0x70, 0x46, 0x65, 0x65,
+	0x52, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f,
+	0x73, 0x77, 0x61, 0x70, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e,
+	0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x53, 0x77, 0x61, 0x70, 0x46, 0x65, 0x65, 0x12, 0x28,
+	0x0a, 0x10, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x66,
+	0x65, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75,
+	0x6d, 0x53, 0x77, 0x61, 0x70, 0x46, 0x65, 0x65, 0x12, 0x39, 0x0a, 0x19, 0x70, 0x6c, 0x75, 0x73,
+	0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x70, 0x6c, 0x75,
+	0x73, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73,
+	0x77, 0x61, 0x70, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x53, 0x77, 0x61, 0x70, 0x12, 0x30, 0x0a, 0x14, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x2a, 0x0a, 0x11, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x47, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x3b, 0x0a, 0x1a, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x69, 0x6e, 0x5f, 0x66, 0x72, 0x6f, 0x6d,
+	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x17, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x77, 0x61, 0x70, 0x69, 0x6e, 0x46, 0x72, 0x6f, 0x6d,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x65, 0x74, 0x69,
+	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x63, 0x72, 0x6d, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x72, 0x65, 0x74, 0x69, 0x72, 0x69, 0x6e,
+	0x67, 0x44, 0x63, 0x72, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2e, 0x0a, 0x0a,
+	0x73, 0x77, 0x61, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x09, 0x73, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x7b, 0x0a, 0x09,
+	0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x77, 0x61, 0x70, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x73, 0x65, 0x74, 0x5f, 0x62, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x65, 0x74, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x82, 0x04, 0x0a, 0x0e, 0x53, 0x77,
+	0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x61, 0x69, 0x72, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61,
+	0x69, 0x72, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x77,
+	0x61, 0x70, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x77, 0x61, 0x70, 0x69, 0x6e, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x77, 0x61, 0x70,
+	0x6f, 0x75, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x77, 0x61, 0x70, 0x6f, 0x75, 0x74, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x77,
+	0x61, 0x70, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x12, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70, 0x69, 0x6e, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f,
+	0x73, 0x77, 0x61, 0x70, 0x6f, 0x75, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x13, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70,
+	0x6f, 0x75, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x77, 0x61,
+	0x70, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x77, 0x61, 0x70, 0x69,
+	0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x73, 0x77, 0x61, 0x70, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x77, 0x61, 0x70, 0x69, 0x6e, 0x46, 0x65, 0x65,
+	0x12, 0x30, 0x0a, 0x14, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x6f,
+	0x75, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12,
+	0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x77, 0x61, 0x70, 0x6f, 0x75, 0x74, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x77, 0x61, 0x70,
+	0x6f, 0x75, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x77, 0x61, 0x70, 0x6f, 0x75, 0x74, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x77, 0x61, 0x70,
+	0x6f, 0x75, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x53, 0x77, 0x61, 0x70, 0x6f, 0x75, 0x74, 0x46, 0x65, 0x65, 0x22, 0xa2,
+	0x04, 0x0a, 0x08, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x61, 0x69, 0x72, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x69,
+	0x72, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x74, 0x6f, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x74, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x74,
+	0x78, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74,
+	0x78, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74, 0x78, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x69, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x62, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x77, 0x61, 0x70, 0x74, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x77, 0x61, 0x70, 0x74, 0x78, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x77, 0x61, 0x70, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x77, 0x61, 0x70, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x77, 0x61, 0x70, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x77, 0x61, 0x70, 0x74, 0x69, 0x6d,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x77, 0x61, 0x70, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x77, 0x61, 0x70, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x77, 0x61, 0x70, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x73, 0x77, 0x61, 0x70, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x77, 0x61, 0x70, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x73, 0x77, 0x61, 0x70, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x6d, 0x73, 0x67, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x6d, 0x73, 0x67, 0x12,
+	0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x69, 0x74, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x69, 0x6e, 0x69, 0x74, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x13, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x6d,
+	0x6f, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x24, 0x0a,
+	0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x15,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x34, 0x0a, 0x0c, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x22, 0x23, 0x0a, 0x09, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x51,
+	0x0a, 0x0e, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x63, 0x61, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x22, 0x4b, 0x0a, 0x09, 0x53, 0x77, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x69, 0x73, 0x73, 0x77, 0x61, 0x70, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x69, 0x73, 0x73, 0x77, 0x61, 0x70, 0x69, 0x6e, 0x12, 0x22, 0x0a, 0x04, 0x73, 0x77,
+	0x61, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e,
+	0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x73, 0x77, 0x61, 0x70, 0x32, 0x9e,
+	0x05, 0x0a, 0x0b, 0x53, 0x77, 0x61, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2e,
+	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x0b, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x10, 0x2e, 0x73,
+	0x77, 0x61, 0x70, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3c,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x13, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x49, 0x44,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3e, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63,
+	0x73, 0x12, 0x13, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x49, 0x44, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x53, 0x77,
+	0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x35, 0x0a, 0x09,
+	0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x69, 0x6e, 0x12, 0x18, 0x2e, 0x73, 0x77, 0x61, 0x70,
+	0x2e, 0x54, 0x78, 0x41, 0x6e, 0x64, 0x50, 0x61, 0x69, 0x72, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x36, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x6f, 0x75,
+	0x74, 0x12, 0x18, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x54, 0x78, 0x41, 0x6e, 0x64, 0x50, 0x61,
+	0x69, 0x72, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x73, 0x77,
+	0x61, 0x70, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x41, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x69, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12,
+	0x19, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x73, 0x77, 0x61,
+	0x70, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x42,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x6f, 0x75, 0x74, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x12, 0x19, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12,
+	0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x33, 0x0a, 0x06, 0x53, 0x77, 0x61, 0x70, 0x69, 0x6e, 0x12, 0x18, 0x2e, 0x73,
+	0x77, 0x61, 0x70, 0x2e, 0x54, 0x78, 0x41, 0x6e, 0x64, 0x50, 0x61, 0x69, 0x72, 0x49, 0x44, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x34, 0x0a, 0x07, 0x53, 0x77, 0x61, 0x70, 0x6f,
+	0x75, 0x74, 0x12, 0x18, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x54, 0x78, 0x41, 0x6e, 0x64, 0x50,
+	0x61, 0x69, 0x72, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x73,
+	0x77, 0x61, 0x70, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x46, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x63, 0x61, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x1b, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74,
+	0x53, 0x63, 0x61, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x14, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x63, 0x61,
+	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x38, 0x0a, 0x0a, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x77,
+	0x61, 0x70, 0x73, 0x12, 0x17, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x73,
+	0x77, 0x61, 0x70, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x42,
+	0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6e,
+	0x79, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x2d, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x72, 0x70, 0x63,
+	0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_swap_proto_rawDescOnce sync.Once
+	file_swap_proto_rawDescData = file_swap_proto_rawDesc
+)
+
+func file_swap_proto_rawDescGZIP() []byte {
+	file_swap_proto_rawDescOnce.Do(func() {
+		file_swap_proto_rawDescData = protoimpl.X.CompressGZIP(file_swap_proto_rawDescData)
+	})
+	return file_swap_proto_rawDescData
+}
+
+var file_swap_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_swap_proto_goTypes = []interface{}{
+	(*Empty)(nil),                 // 0: swap.Empty
+	(*PairIDRequest)(nil),         // 1: swap.PairIDRequest
+	(*TxAndPairIDRequest)(nil),    // 2: swap.TxAndPairIDRequest
+	(*QueryHistoryRequest)(nil),   // 3: swap.QueryHistoryRequest
+	(*LatestScanInfoRequest)(nil), // 4: swap.LatestScanInfoRequest
+	(*WatchSwapsRequest)(nil),     // 5: swap.WatchSwapsRequest
+	(*ServerInfo)(nil),            // 6: swap.ServerInfo
+	(*ChainConfig)(nil),           // 7: swap.ChainConfig
+	(*MaintenanceWindow)(nil),     // 8: swap.MaintenanceWindow
+	(*TokenPairInfo)(nil),         // 9: swap.TokenPairInfo
+	(*TokenConfig)(nil),           // 10: swap.TokenConfig
+	(*SwapState)(nil),             // 11: swap.SwapState
+	(*SwapStatistics)(nil),        // 12: swap.SwapStatistics
+	(*SwapInfo)(nil),              // 13: swap.SwapInfo
+	(*SwapInfoList)(nil),          // 14: swap.SwapInfoList
+	(*PostReply)(nil),             // 15: swap.PostReply
+	(*LatestScanInfo)(nil),        // 16: swap.LatestScanInfo
+	(*SwapEvent)(nil),             // 17: swap.SwapEvent
+}
+var file_swap_proto_depIdxs = []int32{
+	7,  // 0: swap.ServerInfo.src_chain:type_name -> swap.ChainConfig
+	7,  // 1: swap.ServerInfo.dest_chain:type_name -> swap.ChainConfig
+	8,  // 2: swap.ServerInfo.maintenance_windows:type_name -> swap.MaintenanceWindow
+	10, // 3: swap.TokenPairInfo.src_token:type_name -> swap.TokenConfig
+	10, // 4: swap.TokenPairInfo.dest_token:type_name -> swap.TokenConfig
+	11, // 5: swap.TokenConfig.swap_state:type_name -> swap.SwapState
+	13, // 6: swap.SwapInfoList.swaps:type_name -> swap.SwapInfo
+	13, // 7: swap.SwapEvent.swap:type_name -> swap.SwapInfo
+	0,  // 8: swap.SwapService.GetServerInfo:input_type -> swap.Empty
+	1,  // 9: swap.SwapService.GetTokenPairInfo:input_type -> swap.PairIDRequest
+	1,  // 10: swap.SwapService.GetSwapStatistics:input_type -> swap.PairIDRequest
+	2,  // 11: swap.SwapService.GetSwapin:input_type -> swap.TxAndPairIDRequest
+	2,  // 12: swap.SwapService.GetSwapout:input_type -> swap.TxAndPairIDRequest
+	3,  // 13: swap.SwapService.GetSwapinHistory:input_type -> swap.QueryHistoryRequest
+	3,  // 14: swap.SwapService.GetSwapoutHistory:input_type -> swap.QueryHistoryRequest
+	2,  // 15: swap.SwapService.Swapin:input_type -> swap.TxAndPairIDRequest
+	2,  // 16: swap.SwapService.Swapout:input_type -> swap.TxAndPairIDRequest
+	4,  // 17: swap.SwapService.GetLatestScanInfo:input_type -> swap.LatestScanInfoRequest
+	5,  // 18: swap.SwapService.WatchSwaps:input_type -> swap.WatchSwapsRequest
+	6,  // 19: swap.SwapService.GetServerInfo:output_type -> swap.ServerInfo
+	9,  // 20: swap.SwapService.GetTokenPairInfo:output_type -> swap.TokenPairInfo
+	12, // 21: swap.SwapService.GetSwapStatistics:output_type -> swap.SwapStatistics
+	13, // 22: swap.SwapService.GetSwapin:output_type -> swap.SwapInfo
+	13, // 23: swap.SwapService.GetSwapout:output_type -> swap.SwapInfo
+	14, // 24: swap.SwapService.GetSwapinHistory:output_type -> swap.SwapInfoList
+	14, // 25: swap.SwapService.GetSwapoutHistory:output_type -> swap.SwapInfoList
+	15, // 26: swap.SwapService.Swapin:output_type -> swap.PostReply
+	15, // 27: swap.SwapService.Swapout:output_type -> swap.PostReply
+	16, // 28: swap.SwapService.GetLatestScanInfo:output_type -> swap.LatestScanInfo
+	17, // 29: swap.SwapService.WatchSwaps:output_type -> swap.SwapEvent
+	19, // [19:30] is the sub-list for method output_type
+	8,  // [8:19] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_swap_proto_init() }
+func file_swap_proto_init() {
+	if File_swap_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_swap_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Empty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swap_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PairIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swap_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxAndPairIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swap_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swap_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LatestScanInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swap_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchSwapsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swap_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swap_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChainConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swap_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MaintenanceWindow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swap_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenPairInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swap_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swap_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swap_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapStatistics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swap_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swap_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapInfoList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swap_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swap_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LatestScanInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swap_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_swap_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_swap_proto_goTypes,
+		DependencyIndexes: file_swap_proto_depIdxs,
+		MessageInfos:      file_swap_proto_msgTypes,
+	}.Build()
+	File_swap_proto = out.File
+	file_swap_proto_rawDesc = nil
+	file_swap_proto_goTypes = nil
+	file_swap_proto_depIdxs = nil
+}
@@ -0,0 +1,491 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package grpcapi
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// SwapServiceClient is the client API for SwapService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SwapServiceClient interface {
+	GetServerInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ServerInfo, error)
+	GetTokenPairInfo(ctx context.Context, in *PairIDRequest, opts ...grpc.CallOption) (*TokenPairInfo, error)
+	GetSwapStatistics(ctx context.Context, in *PairIDRequest, opts ...grpc.CallOption) (*SwapStatistics, error)
+	GetSwapin(ctx context.Context, in *TxAndPairIDRequest, opts ...grpc.CallOption) (*SwapInfo, error)
+	GetSwapout(ctx context.Context, in *TxAndPairIDRequest, opts ...grpc.CallOption) (*SwapInfo, error)
+	GetSwapinHistory(ctx context.Context, in *QueryHistoryRequest, opts ...grpc.CallOption) (*SwapInfoList, error)
+	GetSwapoutHistory(ctx context.Context, in *QueryHistoryRequest, opts ...grpc.CallOption) (*SwapInfoList, error)
+	Swapin(ctx context.Context, in *TxAndPairIDRequest, opts ...grpc.CallOption) (*PostReply, error)
+	Swapout(ctx context.Context, in *TxAndPairIDRequest, opts ...grpc.CallOption) (*PostReply, error)
+	GetLatestScanInfo(ctx context.Context, in *LatestScanInfoRequest, opts ...grpc.CallOption) (*LatestScanInfo, error)
+	// watch swap status changes, the stream ends when the client cancels it
+	WatchSwaps(ctx context.Context, in *WatchSwapsRequest, opts ...grpc.CallOption) (SwapService_WatchSwapsClient, error)
+}
+
+type swapServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSwapServiceClient(cc grpc.ClientConnInterface) SwapServiceClient {
+	return &swapServiceClient{cc}
+}
+
+func (c *swapServiceClient) GetServerInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ServerInfo, error) {
+	out := new(ServerInfo)
+	err := c.cc.Invoke(ctx, "/swap.SwapService/GetServerInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapServiceClient) GetTokenPairInfo(ctx context.Context, in *PairIDRequest, opts ...grpc.CallOption) (*TokenPairInfo, error) {
+	out := new(TokenPairInfo)
+	err := c.cc.Invoke(ctx, "/swap.SwapService/GetTokenPairInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapServiceClient) GetSwapStatistics(ctx context.Context, in *PairIDRequest, opts ...grpc.CallOption) (*SwapStatistics, error) {
+	out := new(SwapStatistics)
+	err := c.cc.Invoke(ctx, "/swap.SwapService/GetSwapStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapServiceClient) GetSwapin(ctx context.Context, in *TxAndPairIDRequest, opts ...grpc.CallOption) (*SwapInfo, error) {
+	out := new(SwapInfo)
+	err := c.cc.Invoke(ctx, "/swap.SwapService/GetSwapin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapServiceClient) GetSwapout(ctx context.Context, in *TxAndPairIDRequest, opts ...grpc.CallOption) (*SwapInfo, error) {
+	out := new(SwapInfo)
+	err := c.cc.Invoke(ctx, "/swap.SwapService/GetSwapout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapServiceClient) GetSwapinHistory(ctx context.Context, in *QueryHistoryRequest, opts ...grpc.CallOption) (*SwapInfoList, error) {
+	out := new(SwapInfoList)
+	err := c.cc.Invoke(ctx, "/swap.SwapService/GetSwapinHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapServiceClient) GetSwapoutHistory(ctx context.Context, in *QueryHistoryRequest, opts ...grpc.CallOption) (*SwapInfoList, error) {
+	out := new(SwapInfoList)
+	err := c.cc.Invoke(ctx, "/swap.SwapService/GetSwapoutHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapServiceClient) Swapin(ctx context.Context, in *TxAndPairIDRequest, opts ...grpc.CallOption) (*PostReply, error) {
+	out := new(PostReply)
+	err := c.cc.Invoke(ctx, "/swap.SwapService/Swapin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapServiceClient) Swapout(ctx context.Context, in *TxAndPairIDRequest, opts ...grpc.CallOption) (*PostReply, error) {
+	out := new(PostReply)
+	err := c.cc.Invoke(ctx, "/swap.SwapService/Swapout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapServiceClient) GetLatestScanInfo(ctx context.Context, in *LatestScanInfoRequest, opts ...grpc.CallOption) (*LatestScanInfo, error) {
+	out := new(LatestScanInfo)
+	err := c.cc.Invoke(ctx, "/swap.SwapService/GetLatestScanInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapServiceClient) WatchSwaps(ctx context.Context, in *WatchSwapsRequest, opts ...grpc.CallOption) (SwapService_WatchSwapsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SwapService_ServiceDesc.Streams[0], "/swap.SwapService/WatchSwaps", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &swapServiceWatchSwapsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SwapService_WatchSwapsClient interface {
+	Recv() (*SwapEvent, error)
+	grpc.ClientStream
+}
+
+type swapServiceWatchSwapsClient struct {
+	grpc.ClientStream
+}
+
+func (x *swapServiceWatchSwapsClient) Recv() (*SwapEvent, error) {
+	m := new(SwapEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// SwapServiceServer is the server API for SwapService service.
+// All implementations must embed UnimplementedSwapServiceServer
+// for forward compatibility
+type SwapServiceServer interface {
+	GetServerInfo(context.Context, *Empty) (*ServerInfo, error)
+	GetTokenPairInfo(context.Context, *PairIDRequest) (*TokenPairInfo, error)
+	GetSwapStatistics(context.Context, *PairIDRequest) (*SwapStatistics, error)
+	GetSwapin(context.Context, *TxAndPairIDRequest) (*SwapInfo, error)
+	GetSwapout(context.Context, *TxAndPairIDRequest) (*SwapInfo, error)
+	GetSwapinHistory(context.Context, *QueryHistoryRequest) (*SwapInfoList, error)
+	GetSwapoutHistory(context.Context, *QueryHistoryRequest) (*SwapInfoList, error)
+	Swapin(context.Context, *TxAndPairIDRequest) (*PostReply, error)
+	Swapout(context.Context, *TxAndPairIDRequest) (*PostReply, error)
+	GetLatestScanInfo(context.Context, *LatestScanInfoRequest) (*LatestScanInfo, error)
+	// watch swap status changes, the stream ends when the client cancels it
+	WatchSwaps(*WatchSwapsRequest, SwapService_WatchSwapsServer) error
+	mustEmbedUnimplementedSwapServiceServer()
+}
+
+// UnimplementedSwapServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedSwapServiceServer struct {
+}
+
+func (UnimplementedSwapServiceServer) GetServerInfo(context.Context, *Empty) (*ServerInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServerInfo not implemented")
+}
+func (UnimplementedSwapServiceServer) GetTokenPairInfo(context.Context, *PairIDRequest) (*TokenPairInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTokenPairInfo not implemented")
+}
+func (UnimplementedSwapServiceServer) GetSwapStatistics(context.Context, *PairIDRequest) (*SwapStatistics, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSwapStatistics not implemented")
+}
+func (UnimplementedSwapServiceServer) GetSwapin(context.Context, *TxAndPairIDRequest) (*SwapInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSwapin not implemented")
+}
+func (UnimplementedSwapServiceServer) GetSwapout(context.Context, *TxAndPairIDRequest) (*SwapInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSwapout not implemented")
+}
+func (UnimplementedSwapServiceServer) GetSwapinHistory(context.Context, *QueryHistoryRequest) (*SwapInfoList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSwapinHistory not implemented")
+}
+func (UnimplementedSwapServiceServer) GetSwapoutHistory(context.Context, *QueryHistoryRequest) (*SwapInfoList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSwapoutHistory not implemented")
+}
+func (UnimplementedSwapServiceServer) Swapin(context.Context, *TxAndPairIDRequest) (*PostReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Swapin not implemented")
+}
+func (UnimplementedSwapServiceServer) Swapout(context.Context, *TxAndPairIDRequest) (*PostReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Swapout not implemented")
+}
+func (UnimplementedSwapServiceServer) GetLatestScanInfo(context.Context, *LatestScanInfoRequest) (*LatestScanInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLatestScanInfo not implemented")
+}
+func (UnimplementedSwapServiceServer) WatchSwaps(*WatchSwapsRequest, SwapService_WatchSwapsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchSwaps not implemented")
+}
+func (UnimplementedSwapServiceServer) mustEmbedUnimplementedSwapServiceServer() {}
+
+// UnsafeSwapServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SwapServiceServer will
+// result in compilation errors.
+type UnsafeSwapServiceServer interface {
+	mustEmbedUnimplementedSwapServiceServer()
+}
+
+func RegisterSwapServiceServer(s grpc.ServiceRegistrar, srv SwapServiceServer) {
+	s.RegisterService(&SwapService_ServiceDesc, srv)
+}
+
+func _SwapService_GetServerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapServiceServer).GetServerInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swap.SwapService/GetServerInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapServiceServer).GetServerInfo(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapService_GetTokenPairInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PairIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapServiceServer).GetTokenPairInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swap.SwapService/GetTokenPairInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapServiceServer).GetTokenPairInfo(ctx, req.(*PairIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapService_GetSwapStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PairIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapServiceServer).GetSwapStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swap.SwapService/GetSwapStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapServiceServer).GetSwapStatistics(ctx, req.(*PairIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapService_GetSwapin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxAndPairIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapServiceServer).GetSwapin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swap.SwapService/GetSwapin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapServiceServer).GetSwapin(ctx, req.(*TxAndPairIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapService_GetSwapout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxAndPairIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapServiceServer).GetSwapout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swap.SwapService/GetSwapout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapServiceServer).GetSwapout(ctx, req.(*TxAndPairIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapService_GetSwapinHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapServiceServer).GetSwapinHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swap.SwapService/GetSwapinHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapServiceServer).GetSwapinHistory(ctx, req.(*QueryHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapService_GetSwapoutHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapServiceServer).GetSwapoutHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swap.SwapService/GetSwapoutHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapServiceServer).GetSwapoutHistory(ctx, req.(*QueryHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapService_Swapin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxAndPairIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapServiceServer).Swapin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swap.SwapService/Swapin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapServiceServer).Swapin(ctx, req.(*TxAndPairIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapService_Swapout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxAndPairIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapServiceServer).Swapout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swap.SwapService/Swapout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapServiceServer).Swapout(ctx, req.(*TxAndPairIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapService_GetLatestScanInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LatestScanInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapServiceServer).GetLatestScanInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/swap.SwapService/GetLatestScanInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapServiceServer).GetLatestScanInfo(ctx, req.(*LatestScanInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapService_WatchSwaps_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchSwapsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SwapServiceServer).WatchSwaps(m, &swapServiceWatchSwapsServer{stream})
+}
+
+type SwapService_WatchSwapsServer interface {
+	Send(*SwapEvent) error
+	grpc.ServerStream
+}
+
+type swapServiceWatchSwapsServer struct {
+	grpc.ServerStream
+}
+
+func (x *swapServiceWatchSwapsServer) Send(m *SwapEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// SwapService_ServiceDesc is the grpc.ServiceDesc for SwapService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SwapService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "swap.SwapService",
+	HandlerType: (*SwapServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetServerInfo",
+			Handler:    _SwapService_GetServerInfo_Handler,
+		},
+		{
+			MethodName: "GetTokenPairInfo",
+			Handler:    _SwapService_GetTokenPairInfo_Handler,
+		},
+		{
+			MethodName: "GetSwapStatistics",
+			Handler:    _SwapService_GetSwapStatistics_Handler,
+		},
+		{
+			MethodName: "GetSwapin",
+			Handler:    _SwapService_GetSwapin_Handler,
+		},
+		{
+			MethodName: "GetSwapout",
+			Handler:    _SwapService_GetSwapout_Handler,
+		},
+		{
+			MethodName: "GetSwapinHistory",
+			Handler:    _SwapService_GetSwapinHistory_Handler,
+		},
+		{
+			MethodName: "GetSwapoutHistory",
+			Handler:    _SwapService_GetSwapoutHistory_Handler,
+		},
+		{
+			MethodName: "Swapin",
+			Handler:    _SwapService_Swapin_Handler,
+		},
+		{
+			MethodName: "Swapout",
+			Handler:    _SwapService_Swapout_Handler,
+		},
+		{
+			MethodName: "GetLatestScanInfo",
+			Handler:    _SwapService_GetLatestScanInfo_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchSwaps",
+			Handler:       _SwapService_WatchSwaps_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "swap.proto",
+}
@@ -2,27 +2,75 @@ package restapi
 
 import (
 	"encoding/json"
-	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/anyswap/CrossChain-Bridge/common"
+	"github.com/anyswap/CrossChain-Bridge/internal/health"
 	"github.com/anyswap/CrossChain-Bridge/internal/swapapi"
 	"github.com/anyswap/CrossChain-Bridge/params"
+	"github.com/anyswap/CrossChain-Bridge/rpc/apidoc"
+	"github.com/anyswap/CrossChain-Bridge/rpc/apierrors"
 	"github.com/gorilla/mux"
 )
 
 func writeResponse(w http.ResponseWriter, resp interface{}, err error) {
-	// Note: must set header before write header
-	if err == nil {
-		w.Header().Set("Content-Type", "application/json")
+	if err != nil {
+		WriteErrorResponse(w, err)
+		return
 	}
+	// Note: must set header before write header
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	if err == nil {
-		jsonData, _ := json.Marshal(resp)
-		_, _ = w.Write(jsonData)
+	jsonData, _ := json.Marshal(resp)
+	_, _ = w.Write(jsonData)
+}
+
+// WriteErrorResponse write error in catalogue as json body with http status of the error
+func WriteErrorResponse(w http.ResponseWriter, err error) {
+	apiErr := apierrors.Convert(err)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(apiErr.HTTPStatus())
+	jsonData, _ := json.Marshal(apiErr)
+	_, _ = w.Write(jsonData)
+}
+
+func writeHealthResponse(w http.ResponseWriter, res *health.HealthResult) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-cache")
+	if res.IsHealthy() {
+		w.WriteHeader(http.StatusOK)
 	} else {
-		fmt.Fprintln(w, err.Error())
+		w.WriteHeader(http.StatusServiceUnavailable)
 	}
+	jsonData, _ := json.Marshal(res)
+	_, _ = w.Write(jsonData)
+}
+
+// HealthLiveHandler handler
+func HealthLiveHandler(w http.ResponseWriter, r *http.Request) {
+	writeHealthResponse(w, health.CheckLiveness())
+}
+
+// HealthReadyHandler handler
+func HealthReadyHandler(w http.ResponseWriter, r *http.Request) {
+	writeHealthResponse(w, health.CheckReadiness())
+}
+
+// OpenAPIHandler handler
+func OpenAPIHandler(w http.ResponseWriter, r *http.Request) {
+	writeResponse(w, apidoc.GetOpenAPISpec(), nil)
+}
+
+// OpenRPCHandler handler
+func OpenRPCHandler(w http.ResponseWriter, r *http.Request) {
+	writeResponse(w, apidoc.GetOpenRPCSpec(), nil)
+}
+
+// ErrorCatalogueHandler handler
+func ErrorCatalogueHandler(w http.ResponseWriter, r *http.Request) {
+	writeResponse(w, apierrors.GetCatalogue(), nil)
 }
 
 // VersionInfoHandler handler
@@ -170,6 +218,93 @@ func SwapoutHistoryHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// QuoteSwapHandler handler
+func QuoteSwapHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	pairID := vars["pairid"]
+	direction := vars["direction"]
+	amount := vars["amount"]
+	res, err := swapapi.QuoteSwap(pairID, direction, amount)
+	writeResponse(w, res, err)
+}
+
+// AddressOverviewHandler handler
+func AddressOverviewHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	address := vars["address"]
+	res, err := swapapi.GetAddressOverview(address)
+	writeResponse(w, res, err)
+}
+
+// SwapNoncesHandler handler
+func SwapNoncesHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	pairID := vars["pairid"]
+	res, err := swapapi.GetSwapNonces(pairID)
+	writeResponse(w, res, err)
+}
+
+// QuerySwapsHandler handler
+func QuerySwapsHandler(w http.ResponseWriter, r *http.Request) {
+	args, err := getQuerySwapsArgs(r)
+	if err != nil {
+		writeResponse(w, nil, err)
+	} else {
+		res, err := swapapi.QuerySwaps(args)
+		writeResponse(w, res, err)
+	}
+}
+
+func getQuerySwapsArgs(r *http.Request) (args *swapapi.QuerySwapsArgs, err error) {
+	vals := r.URL.Query()
+	args = &swapapi.QuerySwapsArgs{
+		SwapType: vals.Get("swaptype"),
+		PairID:   vals.Get("pairid"),
+		Address:  vals.Get("address"),
+		Bind:     vals.Get("bind"),
+		MinValue: vals.Get("minvalue"),
+		MaxValue: vals.Get("maxvalue"),
+		Order:    vals.Get("order"),
+		Cursor:   vals.Get("cursor"),
+	}
+	for _, statuses := range vals["status"] {
+		for _, statusStr := range strings.Split(statuses, ",") {
+			status, errf := common.GetUint64FromStr(statusStr)
+			if errf != nil {
+				return nil, apierrors.InvalidArgument.Errorf("wrong status '%v'", statusStr)
+			}
+			args.Status = append(args.Status, swapapi.SwapStatus(status))
+		}
+	}
+	uint64Params := map[string]*uint64{
+		"txtimefrom": &args.TxTimeFrom,
+		"txtimeto":   &args.TxTimeTo,
+	}
+	var initTimeFrom, initTimeTo, limit uint64
+	uint64Params["inittimefrom"] = &initTimeFrom
+	uint64Params["inittimeto"] = &initTimeTo
+	uint64Params["limit"] = &limit
+	for name, value := range uint64Params {
+		if str := vals.Get(name); str != "" {
+			*value, err = common.GetUint64FromStr(str)
+			if err != nil {
+				return nil, apierrors.InvalidArgument.Errorf("wrong %v '%v'", name, str)
+			}
+		}
+	}
+	args.InitTimeFrom = int64(initTimeFrom)
+	args.InitTimeTo = int64(initTimeTo)
+	args.Limit = int(limit)
+	if str := vals.Get("hasswaptx"); str != "" {
+		hasSwapTx, errf := strconv.ParseBool(str)
+		if errf != nil {
+			return nil, apierrors.InvalidArgument.Errorf("wrong hasswaptx '%v'", str)
+		}
+		args.HasSwapTx = &hasSwapTx
+	}
+	return args, nil
+}
+
 // PostSwapinHandler handler
 func PostSwapinHandler(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
@@ -1,14 +1,18 @@
 package rpcapi
 
 import (
-	"fmt"
+	"encoding/json"
 	"net/http"
 	"strings"
 
 	"github.com/anyswap/CrossChain-Bridge/admin"
 	"github.com/anyswap/CrossChain-Bridge/common"
+	"github.com/anyswap/CrossChain-Bridge/dcrm"
+	"github.com/anyswap/CrossChain-Bridge/internal/metrics"
+	"github.com/anyswap/CrossChain-Bridge/log"
 	"github.com/anyswap/CrossChain-Bridge/mongodb"
 	"github.com/anyswap/CrossChain-Bridge/params"
+	"github.com/anyswap/CrossChain-Bridge/rpc/apierrors"
 	"github.com/anyswap/CrossChain-Bridge/tokens"
 	"github.com/anyswap/CrossChain-Bridge/worker"
 )
@@ -27,7 +31,7 @@ const (
 // AdminCall admin call
 func (s *RPCAPI) AdminCall(r *http.Request, rawTx, result *string) (err error) {
 	if !params.HasAdmin() {
-		return fmt.Errorf("no admin is configed")
+		return apierrors.Forbidden.New("no admin is configed")
 	}
 	tx, err := admin.DecodeTransaction(*rawTx)
 	if err != nil {
@@ -37,20 +41,65 @@ func (s *RPCAPI) AdminCall(r *http.Request, rawTx, result *string) (err error) {
 	if err != nil {
 		return err
 	}
-	if !params.IsAdmin(sender.String()) {
-		return fmt.Errorf("sender %v is not admin", sender.String())
+	args.RawTx = *rawTx
+	// record admin call (including denied ones) keyed by tx hash, which also rejects replays
+	txHash := tx.Hash().Hex()
+	err = mongodb.AddAdminCall(&mongodb.MgoAdminCall{
+		Key:      txHash,
+		Signer:   sender.String(),
+		Method:   args.Method,
+		Params:   getAuditParams(args),
+		CallTime: args.Timestamp,
+	})
+	if err == mongodb.ErrItemIsDup {
+		return apierrors.AdminTxReplayed.Errorf("admin tx %v is already called", txHash)
 	}
-	return doCall(args, result)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = mongodb.UpdateAdminCallResult(txHash, *result, err)
+	}()
+	if !params.IsAdminMember(sender.String()) {
+		log.Warn("[admin] reject admin call of non admin", "sender", sender.String(), "method", args.Method, "params", getAuditParams(args))
+		metrics.IncAdminDeniedCall(args.Method)
+		return apierrors.Forbidden.Errorf("sender %v is not admin", sender.String())
+	}
+	return adminCall(txHash, sender.String(), args, result)
+}
+
+// get params recorded in audit log and proposal, large payload is summarized
+func getAuditParams(args *admin.CallArgs) []string {
+	if args.Method == blacklistMethod {
+		return getBlacklistAuditParams(args.Params)
+	}
+	return args.Params
+}
+
+func adminCall(txHash, sender string, args *admin.CallArgs, result *string) error {
+	// permission of proposal is checked by its admin method when approving or cancelling
+	if args.Method == params.AdminProposalsMethod {
+		return proposals(sender, args, result)
+	}
+	err := checkAdminPermission(sender, args.Method, args.Params)
+	if err != nil {
+		return err
+	}
+	if threshold := getApprovalThreshold(args); threshold > 1 {
+		return propose(txHash, sender, args, threshold, result)
+	}
+	return doCall(sender, args, result)
 }
 
-func doCall(args *admin.CallArgs, result *string) error {
+// caller is the admin (or comma separated admins of proposal) calling the method
+func doCall(caller string, args *admin.CallArgs, result *string) error {
 	switch args.Method {
-	case "blacklist":
-		return blacklist(args, result)
+	case blacklistMethod:
+		return blacklist(caller, args, result)
 	case "bigvalue":
 		return bigvalue(args, result)
 	case "maintain":
-		return maintain(args, result)
+		return maintain(caller, args, result)
 	case "reverify":
 		return reverify(args, result)
 	case "reswap":
@@ -59,53 +108,34 @@ func doCall(args *admin.CallArgs, result *string) error {
 		return replaceswap(args, result)
 	case "manual":
 		return manual(args, result)
+	case params.AdminPayoutMethod:
+		return payout(caller, args, result)
 	case "setnonce":
 		return setnonce(args, result)
 	case "addpair":
-		return addpair(args, result)
-	default:
-		return fmt.Errorf("unknown admin method '%v'", args.Method)
-	}
-}
-
-func blacklist(args *admin.CallArgs, result *string) (err error) {
-	if len(args.Params) != 3 {
-		return fmt.Errorf("wrong number of params, have %v want 3", len(args.Params))
-	}
-	operation := args.Params[0]
-	address := args.Params[1]
-	pairID := args.Params[2]
-	isBlacked := false
-	isQuery := false
-	switch operation {
-	case "add":
-		err = mongodb.AddToBlacklist(address, pairID)
-	case "remove":
-		err = mongodb.RemoveFromBlacklist(address, pairID)
-	case "query":
-		isQuery = true
-		isBlacked, err = mongodb.QueryBlacklist(address, pairID)
+		return addpair(caller, args, result)
+	case "updatepair":
+		return updatepair(caller, args, result)
+	case "removepair":
+		return removepair(caller, args, result)
+	case "dcrmhealth":
+		return dcrmhealth(args, result)
+	case "migration":
+		return migration(args, result)
+	case "audit":
+		return audit(args, result)
+	case bulkMethod:
+		return bulk(caller, args, result)
+	case maintenanceMethod:
+		return maintenance(caller, args, result)
 	default:
-		return fmt.Errorf("unknown operation '%v'", operation)
-	}
-	if err != nil {
-		return err
+		return apierrors.InvalidArgument.Errorf("unknown admin method '%v'", args.Method)
 	}
-	if isQuery {
-		if isBlacked {
-			*result = "is in blacklist"
-		} else {
-			*result = "is not in blacklist"
-		}
-	} else {
-		*result = successReuslt
-	}
-	return nil
 }
 
 func bigvalue(args *admin.CallArgs, result *string) (err error) {
 	if len(args.Params) != 4 {
-		return fmt.Errorf("wrong number of params, have %v want 4", len(args.Params))
+		return apierrors.InvalidArgument.Errorf("wrong number of params, have %v want 4", len(args.Params))
 	}
 	operation := args.Params[0]
 	txid := args.Params[1]
@@ -117,7 +147,7 @@ func bigvalue(args *admin.CallArgs, result *string) (err error) {
 	case passSwapoutOp:
 		err = mongodb.PassSwapoutBigValue(txid, pairID, bind)
 	default:
-		return fmt.Errorf("unknown operation '%v'", operation)
+		return apierrors.InvalidArgument.Errorf("unknown operation '%v'", operation)
 	}
 	if err != nil {
 		return err
@@ -126,14 +156,21 @@ func bigvalue(args *admin.CallArgs, result *string) (err error) {
 	return nil
 }
 
-func maintain(args *admin.CallArgs, result *string) (err error) {
-	if len(args.Params) != 3 {
-		return fmt.Errorf("wrong number of params, have %v want 3", len(args.Params))
+// maintain open or close swap of token pairs, the state is stored in database
+// to survive restarts and be synced to other server nodes
+func maintain(caller string, args *admin.CallArgs, result *string) (err error) {
+	if !(len(args.Params) == 3 || len(args.Params) == 4) {
+		return apierrors.InvalidArgument.Errorf("wrong number of params, have %v want 3 or 4", len(args.Params))
 	}
 	operation := args.Params[0]
 	direction := args.Params[1]
 	pairIDs := args.Params[2]
 
+	var reason string
+	if len(args.Params) > 3 {
+		reason = args.Params[3]
+	}
+
 	var newDisableFlag bool
 	switch operation {
 	case "open":
@@ -141,7 +178,7 @@ func maintain(args *admin.CallArgs, result *string) (err error) {
 	case "close":
 		newDisableFlag = true
 	default:
-		return fmt.Errorf("unknown operation '%v'", operation)
+		return apierrors.InvalidArgument.Errorf("unknown operation '%v'", operation)
 	}
 
 	isDeposit := false
@@ -155,7 +192,7 @@ func maintain(args *admin.CallArgs, result *string) (err error) {
 		isDeposit = true
 		isWithdraw = true
 	default:
-		return fmt.Errorf("unknown direction '%v'", direction)
+		return apierrors.InvalidArgument.Errorf("unknown direction '%v'", direction)
 	}
 
 	var pairIDSlice []string
@@ -172,12 +209,18 @@ func maintain(args *admin.CallArgs, result *string) (err error) {
 			failedPairs += " " + pairID
 			continue
 		}
-		if isDeposit {
-			pairCfg.SrcToken.DisableSwap = newDisableFlag
+		state := &mongodb.MgoSwapState{
+			DisableSwap: newDisableFlag,
+			Reason:      reason,
+			SetBy:       strings.ToLower(caller),
 		}
-
-		if isWithdraw {
-			pairCfg.DestToken.DisableSwap = newDisableFlag
+		if isDeposit && setSwapState(pairID, true, pairCfg.SrcToken, state) != nil {
+			failedPairs += " " + pairID
+			continue
+		}
+		if isWithdraw && setSwapState(pairID, false, pairCfg.DestToken, state) != nil {
+			failedPairs += " " + pairID
+			continue
 		}
 
 		successPairs += " " + pairID
@@ -192,9 +235,23 @@ func maintain(args *admin.CallArgs, result *string) (err error) {
 	return nil
 }
 
+func setSwapState(pairID string, isDeposit bool, tokenCfg *tokens.TokenConfig, state *mongodb.MgoSwapState) error {
+	err := mongodb.UpdatePairState(pairID, isDeposit, state)
+	if err != nil {
+		return err
+	}
+	tokenCfg.SetSwapState(&tokens.SwapState{
+		DisableSwap: state.DisableSwap,
+		Reason:      state.Reason,
+		SetBy:       state.SetBy,
+		Timestamp:   state.Timestamp,
+	})
+	return nil
+}
+
 func getOpTxAndPairID(args *admin.CallArgs) (operation, txid, pairID, bind, forceOpt string, err error) {
 	if !(len(args.Params) == 4 || len(args.Params) == 5) {
-		err = fmt.Errorf("wrong number of params, have %v want 4 or 5", len(args.Params))
+		err = apierrors.InvalidArgument.Errorf("wrong number of params, have %v want 4 or 5", len(args.Params))
 		return
 	}
 	operation = args.Params[0]
@@ -205,7 +262,7 @@ func getOpTxAndPairID(args *admin.CallArgs) (operation, txid, pairID, bind, forc
 	if len(args.Params) > 4 {
 		forceOpt = args.Params[4]
 		if forceOpt != forceFlag {
-			err = fmt.Errorf("wrong force flag %v, must be %v", forceOpt, forceFlag)
+			err = apierrors.InvalidArgument.Errorf("wrong force flag %v, must be %v", forceOpt, forceFlag)
 			return
 		}
 	}
@@ -223,7 +280,7 @@ func reverify(args *admin.CallArgs, result *string) (err error) {
 	case swapoutOp:
 		err = mongodb.ReverifySwapout(txid, pairID, bind)
 	default:
-		return fmt.Errorf("unknown operation '%v'", operation)
+		return apierrors.InvalidArgument.Errorf("unknown operation '%v'", operation)
 	}
 	if err != nil {
 		return err
@@ -243,7 +300,7 @@ func reswap(args *admin.CallArgs, result *string) (err error) {
 	case swapoutOp:
 		err = mongodb.Reswapout(txid, pairID, bind, forceOpt)
 	default:
-		return fmt.Errorf("unknown operation '%v'", operation)
+		return apierrors.InvalidArgument.Errorf("unknown operation '%v'", operation)
 	}
 	if err != nil {
 		return err
@@ -254,7 +311,7 @@ func reswap(args *admin.CallArgs, result *string) (err error) {
 
 func replaceswap(args *admin.CallArgs, result *string) (err error) {
 	if len(args.Params) != 5 {
-		err = fmt.Errorf("wrong number of params, have %v want 5", len(args.Params))
+		err = apierrors.InvalidArgument.Errorf("wrong number of params, have %v want 5", len(args.Params))
 		return
 	}
 	operation := args.Params[0]
@@ -270,7 +327,7 @@ func replaceswap(args *admin.CallArgs, result *string) (err error) {
 	case swapoutOp:
 		txHash, err = worker.ReplaceSwapout(txid, pairID, bind, gasPrice)
 	default:
-		return fmt.Errorf("unknown operation '%v'", operation)
+		return apierrors.InvalidArgument.Errorf("unknown operation '%v'", operation)
 	}
 	if err != nil {
 		return err
@@ -279,9 +336,25 @@ func replaceswap(args *admin.CallArgs, result *string) (err error) {
 	return nil
 }
 
+// payout create manual payout of swap which can not be processed normally,
+// params are '<swapin|swapout> <txid> <pairID> <to> <value>',
+// value is the origin value in smallest unit, swap fee is deducted as normal swaps.
+func payout(caller string, args *admin.CallArgs, result *string) (err error) {
+	payoutArgs, err := worker.ParseManualPayoutParams(args.Params)
+	if err != nil {
+		return apierrors.InvalidArgument.New(err.Error())
+	}
+	err = worker.AddManualPayout(payoutArgs, args.RawTx, args.ApproveTxs, caller)
+	if err != nil {
+		return err
+	}
+	*result = successReuslt
+	return nil
+}
+
 func manual(args *admin.CallArgs, result *string) (err error) {
 	if !(len(args.Params) == 4 || len(args.Params) == 5) {
-		return fmt.Errorf("wrong number of params, have %v want 4 or 5", len(args.Params))
+		return apierrors.InvalidArgument.Errorf("wrong number of params, have %v want 4 or 5", len(args.Params))
 	}
 	operation := args.Params[0]
 	txid := args.Params[1]
@@ -308,7 +381,7 @@ func manual(args *admin.CallArgs, result *string) (err error) {
 		isSwapin = false
 		isPass = false
 	default:
-		return fmt.Errorf("unknown operation '%v'", operation)
+		return apierrors.InvalidArgument.Errorf("unknown operation '%v'", operation)
 	}
 	err = mongodb.ManualManageSwap(txid, pairID, bind, memo, isSwapin, isPass)
 	if err != nil {
@@ -320,12 +393,12 @@ func manual(args *admin.CallArgs, result *string) (err error) {
 
 func setnonce(args *admin.CallArgs, result *string) (err error) {
 	if len(args.Params) != 3 {
-		return fmt.Errorf("wrong number of params, have %v want 3", len(args.Params))
+		return apierrors.InvalidArgument.Errorf("wrong number of params, have %v want 3", len(args.Params))
 	}
 	operation := args.Params[0]
 	nonce, err := common.GetUint64FromStr(args.Params[1])
 	if err != nil {
-		return fmt.Errorf("wrong nonce value, %v", err)
+		return apierrors.InvalidArgument.Errorf("wrong nonce value, %v", err)
 	}
 	pairID := args.Params[2]
 	var bridge tokens.CrossChainBridge
@@ -335,27 +408,79 @@ func setnonce(args *admin.CallArgs, result *string) (err error) {
 	case swapoutOp:
 		bridge = tokens.SrcBridge
 	default:
-		return fmt.Errorf("unknown operation '%v'", operation)
+		return apierrors.InvalidArgument.Errorf("unknown operation '%v'", operation)
 	}
 	nonceSetter, ok := bridge.(tokens.NonceSetter)
 	if !ok {
-		return fmt.Errorf("nonce setter not supported")
+		return apierrors.InvalidArgument.New("nonce setter not supported")
 	}
 	nonceSetter.SetNonce(pairID, nonce)
 	*result = successReuslt
 	return nil
 }
 
-func addpair(args *admin.CallArgs, result *string) (err error) {
+func addpair(caller string, args *admin.CallArgs, result *string) (err error) {
+	if len(args.Params) != 1 {
+		return apierrors.InvalidArgument.Errorf("wrong number of params, have %v want 1", len(args.Params))
+	}
+	configFile := args.Params[0]
+	_, err = worker.AddTokenPair(configFile, caller)
+	if err != nil {
+		return err
+	}
+	*result = successReuslt
+	return nil
+}
+
+func updatepair(caller string, args *admin.CallArgs, result *string) (err error) {
 	if len(args.Params) != 1 {
-		return fmt.Errorf("wrong number of params, have %v want 1", len(args.Params))
+		return apierrors.InvalidArgument.Errorf("wrong number of params, have %v want 1", len(args.Params))
 	}
 	configFile := args.Params[0]
-	pairConfig, err := tokens.AddPairConfig(configFile)
+	_, err = worker.UpdateTokenPair(configFile, caller)
+	if err != nil {
+		return err
+	}
+	*result = successReuslt
+	return nil
+}
+
+func removepair(caller string, args *admin.CallArgs, result *string) (err error) {
+	if len(args.Params) != 1 {
+		return apierrors.InvalidArgument.Errorf("wrong number of params, have %v want 1", len(args.Params))
+	}
+	pairID := args.Params[0]
+	_, err = worker.RemoveTokenPair(pairID, caller)
 	if err != nil {
 		return err
 	}
-	worker.AddSwapJob(pairConfig)
 	*result = successReuslt
 	return nil
 }
+
+func dcrmhealth(args *admin.CallArgs, result *string) (err error) {
+	if len(args.Params) != 0 {
+		return apierrors.InvalidArgument.Errorf("wrong number of params, have %v want 0", len(args.Params))
+	}
+	if !params.IsDcrmEnabled() {
+		return apierrors.ServiceUnavailable.New("dcrm is disabled")
+	}
+	data, err := json.Marshal(dcrm.GetHealthInfo())
+	if err != nil {
+		return err
+	}
+	*result = string(data)
+	return nil
+}
+
+func migration(args *admin.CallArgs, result *string) (err error) {
+	if len(args.Params) != 0 {
+		return apierrors.InvalidArgument.Errorf("wrong number of params, have %v want 0", len(args.Params))
+	}
+	data, err := json.Marshal(worker.GetMigrationStatus())
+	if err != nil {
+		return err
+	}
+	*result = string(data)
+	return nil
+}
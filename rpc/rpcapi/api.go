@@ -1,11 +1,12 @@
 package rpcapi
 
 import (
-	"errors"
 	"net/http"
 
 	"github.com/anyswap/CrossChain-Bridge/internal/swapapi"
 	"github.com/anyswap/CrossChain-Bridge/params"
+	"github.com/anyswap/CrossChain-Bridge/rpc/apierrors"
+	"github.com/anyswap/CrossChain-Bridge/rpc/apitypes"
 	"github.com/anyswap/CrossChain-Bridge/tokens"
 )
 
@@ -22,6 +23,12 @@ func (s *RPCAPI) GetVersionInfo(r *http.Request, args *RPCNullArgs, result *stri
 	return nil
 }
 
+// GetErrorCatalogue api
+func (s *RPCAPI) GetErrorCatalogue(r *http.Request, args *RPCNullArgs, result *[]*apierrors.ErrorInfo) error {
+	*result = apierrors.GetCatalogue()
+	return nil
+}
+
 // GetServerInfo api
 func (s *RPCAPI) GetServerInfo(r *http.Request, args *RPCNullArgs, result *swapapi.ServerInfo) error {
 	res, err := swapapi.GetServerInfo()
@@ -50,28 +57,24 @@ func (s *RPCAPI) GetSwapStatistics(r *http.Request, pairID *string, result *swap
 }
 
 // RPCTxAndPairIDArgs txid and pairID
-type RPCTxAndPairIDArgs struct {
-	TxID   string `json:"txid"`
-	PairID string `json:"pairid"`
-	Bind   string `json:"bind"`
-}
+type RPCTxAndPairIDArgs = apitypes.TxAndPairIDArgs
 
-func (args *RPCTxAndPairIDArgs) getTxAndPairID() (txid, pairID, bind *string, err error) {
+func getTxAndPairID(args *RPCTxAndPairIDArgs) (txid, pairID, bind *string, err error) {
 	txid = &args.TxID
 	pairID = &args.PairID
 	bind = &args.Bind
 	if *txid == "" {
-		return nil, nil, nil, errors.New("empty tx id")
+		return nil, nil, nil, apierrors.InvalidArgument.New("empty tx id")
 	}
 	if *pairID == "" {
-		return nil, nil, nil, errors.New("empty pair id")
+		return nil, nil, nil, apierrors.InvalidArgument.New("empty pair id")
 	}
 	return txid, pairID, bind, nil
 }
 
 // GetRawSwapin api
 func (s *RPCAPI) GetRawSwapin(r *http.Request, args *RPCTxAndPairIDArgs, result *swapapi.Swap) error {
-	txid, pairID, bind, err := args.getTxAndPairID()
+	txid, pairID, bind, err := getTxAndPairID(args)
 	if err != nil {
 		return err
 	}
@@ -84,7 +87,7 @@ func (s *RPCAPI) GetRawSwapin(r *http.Request, args *RPCTxAndPairIDArgs, result
 
 // GetRawSwapinResult api
 func (s *RPCAPI) GetRawSwapinResult(r *http.Request, args *RPCTxAndPairIDArgs, result *swapapi.SwapResult) error {
-	txid, pairID, bind, err := args.getTxAndPairID()
+	txid, pairID, bind, err := getTxAndPairID(args)
 	if err != nil {
 		return err
 	}
@@ -97,7 +100,7 @@ func (s *RPCAPI) GetRawSwapinResult(r *http.Request, args *RPCTxAndPairIDArgs, r
 
 // GetSwapin api
 func (s *RPCAPI) GetSwapin(r *http.Request, args *RPCTxAndPairIDArgs, result *swapapi.SwapInfo) error {
-	txid, pairID, bind, err := args.getTxAndPairID()
+	txid, pairID, bind, err := getTxAndPairID(args)
 	if err != nil {
 		return err
 	}
@@ -110,7 +113,7 @@ func (s *RPCAPI) GetSwapin(r *http.Request, args *RPCTxAndPairIDArgs, result *sw
 
 // GetRawSwapout api
 func (s *RPCAPI) GetRawSwapout(r *http.Request, args *RPCTxAndPairIDArgs, result *swapapi.Swap) error {
-	txid, pairID, bind, err := args.getTxAndPairID()
+	txid, pairID, bind, err := getTxAndPairID(args)
 	if err != nil {
 		return err
 	}
@@ -123,7 +126,7 @@ func (s *RPCAPI) GetRawSwapout(r *http.Request, args *RPCTxAndPairIDArgs, result
 
 // GetRawSwapoutResult api
 func (s *RPCAPI) GetRawSwapoutResult(r *http.Request, args *RPCTxAndPairIDArgs, result *swapapi.SwapResult) error {
-	txid, pairID, bind, err := args.getTxAndPairID()
+	txid, pairID, bind, err := getTxAndPairID(args)
 	if err != nil {
 		return err
 	}
@@ -136,7 +139,7 @@ func (s *RPCAPI) GetRawSwapoutResult(r *http.Request, args *RPCTxAndPairIDArgs,
 
 // GetSwapout api
 func (s *RPCAPI) GetSwapout(r *http.Request, args *RPCTxAndPairIDArgs, result *swapapi.SwapInfo) error {
-	txid, pairID, bind, err := args.getTxAndPairID()
+	txid, pairID, bind, err := getTxAndPairID(args)
 	if err != nil {
 		return err
 	}
@@ -147,14 +150,48 @@ func (s *RPCAPI) GetSwapout(r *http.Request, args *RPCTxAndPairIDArgs, result *s
 	return err
 }
 
-// RPCQueryHistoryArgs args
-type RPCQueryHistoryArgs struct {
-	Address string `json:"address"`
-	PairID  string `json:"pairid"`
-	Offset  int    `json:"offset"`
-	Limit   int    `json:"limit"`
+// QuerySwaps api
+func (s *RPCAPI) QuerySwaps(r *http.Request, args *swapapi.QuerySwapsArgs, result *swapapi.QuerySwapsResult) error {
+	res, err := swapapi.QuerySwaps(args)
+	if err == nil && res != nil {
+		*result = *res
+	}
+	return err
+}
+
+// RPCQuoteSwapArgs args
+type RPCQuoteSwapArgs = apitypes.QuoteSwapArgs
+
+// QuoteSwap api
+func (s *RPCAPI) QuoteSwap(r *http.Request, args *RPCQuoteSwapArgs, result *swapapi.QuoteSwapResult) error {
+	res, err := swapapi.QuoteSwap(args.PairID, args.Direction, args.Amount)
+	if err == nil && res != nil {
+		*result = *res
+	}
+	return err
 }
 
+// GetAddressOverview api
+func (s *RPCAPI) GetAddressOverview(r *http.Request, address *string, result *swapapi.AddressOverview) error {
+	res, err := swapapi.GetAddressOverview(*address)
+	if err == nil && res != nil {
+		*result = *res
+	}
+	return err
+}
+
+// GetSwapNonces api
+func (s *RPCAPI) GetSwapNonces(r *http.Request, pairID *string, result *[]*swapapi.SwapNonceInfo) error {
+	res, err := swapapi.GetSwapNonces(*pairID)
+	if err == nil && res != nil {
+		*result = res
+	}
+	return err
+}
+
+// RPCQueryHistoryArgs args
+type RPCQueryHistoryArgs = apitypes.QueryHistoryArgs
+
 // GetSwapinHistory api
 func (s *RPCAPI) GetSwapinHistory(r *http.Request, args *RPCQueryHistoryArgs, result *[]*swapapi.SwapInfo) error {
 	res, err := swapapi.GetSwapinHistory(args.Address, args.PairID, args.Offset, args.Limit)
@@ -175,7 +212,7 @@ func (s *RPCAPI) GetSwapoutHistory(r *http.Request, args *RPCQueryHistoryArgs, r
 
 // Swapin api
 func (s *RPCAPI) Swapin(r *http.Request, args *RPCTxAndPairIDArgs, result *swapapi.PostResult) error {
-	txid, pairID, _, err := args.getTxAndPairID()
+	txid, pairID, _, err := getTxAndPairID(args)
 	if err != nil {
 		return err
 	}
@@ -188,7 +225,7 @@ func (s *RPCAPI) Swapin(r *http.Request, args *RPCTxAndPairIDArgs, result *swapa
 
 // RetrySwapin api
 func (s *RPCAPI) RetrySwapin(r *http.Request, args *RPCTxAndPairIDArgs, result *swapapi.PostResult) error {
-	txid, pairID, _, err := args.getTxAndPairID()
+	txid, pairID, _, err := getTxAndPairID(args)
 	if err != nil {
 		return err
 	}
@@ -200,10 +237,7 @@ func (s *RPCAPI) RetrySwapin(r *http.Request, args *RPCTxAndPairIDArgs, result *
 }
 
 // RPCP2shSwapinArgs args
-type RPCP2shSwapinArgs struct {
-	TxID string `json:"txid"`
-	Bind string `json:"bind"`
-}
+type RPCP2shSwapinArgs = apitypes.P2shSwapinArgs
 
 // P2shSwapin api
 func (s *RPCAPI) P2shSwapin(r *http.Request, args *RPCP2shSwapinArgs, result *swapapi.PostResult) error {
@@ -216,7 +250,7 @@ func (s *RPCAPI) P2shSwapin(r *http.Request, args *RPCP2shSwapinArgs, result *sw
 
 // Swapout api
 func (s *RPCAPI) Swapout(r *http.Request, args *RPCTxAndPairIDArgs, result *swapapi.PostResult) error {
-	txid, pairID, _, err := args.getTxAndPairID()
+	txid, pairID, _, err := getTxAndPairID(args)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,72 @@
+package rpcapi
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/anyswap/CrossChain-Bridge/admin"
+	"github.com/anyswap/CrossChain-Bridge/common"
+	"github.com/anyswap/CrossChain-Bridge/mongodb"
+	"github.com/anyswap/CrossChain-Bridge/rpc/apierrors"
+)
+
+const (
+	defaultAuditLimit = 20
+	maxAuditLimit     = 1000
+)
+
+// audit query admin call records, params are optional 'key=value' filters
+// of 'signer', 'method', 'from' and 'to' (unix seconds) and 'limit'
+func audit(args *admin.CallArgs, result *string) (err error) {
+	filter := &mongodb.AdminCallFilter{}
+	limit := defaultAuditLimit
+	for _, param := range args.Params {
+		parts := strings.SplitN(param, "=", 2)
+		if len(parts) != 2 {
+			return apierrors.InvalidArgument.Errorf("wrong param '%v', must be 'key=value'", param)
+		}
+		key, value := parts[0], parts[1]
+		switch key {
+		case "signer":
+			filter.Signer = value
+		case "method":
+			filter.Method = value
+		case "from":
+			filter.TimeFrom, err = parseNumberParam(key, value)
+		case "to":
+			filter.TimeTo, err = parseNumberParam(key, value)
+		case "limit":
+			var num int64
+			num, err = parseNumberParam(key, value)
+			limit = int(num)
+		default:
+			return apierrors.InvalidArgument.Errorf("unknown param key '%v'", key)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if limit <= 0 {
+		limit = defaultAuditLimit
+	} else if limit > maxAuditLimit {
+		limit = maxAuditLimit
+	}
+	records, err := mongodb.FindAdminCalls(filter, limit)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(records)
+	if err != nil {
+		return err
+	}
+	*result = string(data)
+	return nil
+}
+
+func parseNumberParam(key, value string) (int64, error) {
+	num, err := common.GetUint64FromStr(value)
+	if err != nil {
+		return 0, apierrors.InvalidArgument.Errorf("wrong value of '%v', %v", key, err)
+	}
+	return int64(num), nil
+}
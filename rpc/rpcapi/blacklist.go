@@ -0,0 +1,436 @@
+package rpcapi
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/anyswap/CrossChain-Bridge/admin"
+	"github.com/anyswap/CrossChain-Bridge/mongodb"
+	"github.com/anyswap/CrossChain-Bridge/rpc/apierrors"
+	"github.com/anyswap/CrossChain-Bridge/tokens"
+)
+
+const (
+	blacklistMethod = "blacklist"
+
+	addBlacklistOp    = "add"
+	removeBlacklistOp = "remove"
+	queryBlacklistOp  = "query"
+	importBlacklistOp = "import"
+	exportBlacklistOp = "export"
+	listBlacklistOp   = "list"
+
+	csvFormat  = "csv"
+	jsonFormat = "json"
+
+	defaultBlacklistLimit = 100
+	maxBlacklistLimit     = 1000
+	maxBlacklistImport    = 100000
+	// the admin tx carrying import data is stored in proposal in hex,
+	// limit the data size to keep the proposal well under the 16MB document limit
+	maxBlacklistImportSize = 4 * 1024 * 1024
+)
+
+var blacklistCSVColumns = []string{"address", "pairid", "reason", "source", "expire"}
+
+// blacklistOptions are the optional 'key=value' params of blacklist operations
+type blacklistOptions struct {
+	pairID string
+	reason string
+	source string
+	expire int64 // unix seconds
+	format string
+	offset int
+	limit  int
+}
+
+// BlacklistImportEntry entry of imported blacklist in json format
+type BlacklistImportEntry struct {
+	Address string
+	PairID  string
+	Reason  string
+	Source  string
+	Expire  int64
+}
+
+// BlacklistImportResult result of blacklist import
+type BlacklistImportResult struct {
+	Imported int
+}
+
+// BlacklistListResult result of blacklist list
+type BlacklistListResult struct {
+	Total   int
+	Offset  int
+	Entries []*mongodb.MgoBlackAccount
+}
+
+// blacklist manage blacklist, params are
+// 'add <address> <pairID> [reason=] [source=] [expire=]',
+// 'remove <address> <pairID>', 'query <address> <pairID>',
+// 'import <csv|json> <data> [pairid=] [reason=] [source=] [expire=]',
+// 'export [pairid=] [format=csv|json]' or 'list [pairid=] [offset=] [limit=]',
+// pairID 'all' means the entry blocks all pairs, expire is unix seconds.
+func blacklist(caller string, args *admin.CallArgs, result *string) (err error) {
+	if len(args.Params) == 0 {
+		return apierrors.InvalidArgument.New("wrong number of params, have 0 want at least 1")
+	}
+	switch operation := args.Params[0]; operation {
+	case importBlacklistOp:
+		return importBlacklist(caller, args.Params[1:], result)
+	case exportBlacklistOp:
+		return exportBlacklist(args.Params[1:], result)
+	case listBlacklistOp:
+		return listBlacklist(args.Params[1:], result)
+	case addBlacklistOp:
+		if len(args.Params) < 3 {
+			return apierrors.InvalidArgument.Errorf("wrong number of params, have %v want at least 3", len(args.Params))
+		}
+	case removeBlacklistOp, queryBlacklistOp:
+		if len(args.Params) != 3 {
+			return apierrors.InvalidArgument.Errorf("wrong number of params, have %v want 3", len(args.Params))
+		}
+	default:
+		return apierrors.InvalidArgument.Errorf("unknown operation '%v'", operation)
+	}
+	operation := args.Params[0]
+	address := args.Params[1]
+	pairID := args.Params[2]
+	isBlacked := false
+	isQuery := false
+	switch operation {
+	case addBlacklistOp:
+		var opts *blacklistOptions
+		opts, err = parseBlacklistOptions(args.Params[3:], "reason", "source", "expire")
+		if err != nil {
+			return err
+		}
+		err = mongodb.AddToBlacklist(&mongodb.MgoBlackAccount{
+			Address:    address,
+			PairID:     pairID,
+			Reason:     opts.reason,
+			Source:     getBlacklistSource(opts.source, caller),
+			ExpireTime: opts.expire,
+		})
+	case removeBlacklistOp:
+		err = mongodb.RemoveFromBlacklist(address, pairID)
+	case queryBlacklistOp:
+		isQuery = true
+		isBlacked, err = mongodb.QueryBlacklist(address, pairID)
+	}
+	if err != nil {
+		return err
+	}
+	if isQuery {
+		if isBlacked {
+			*result = "is in blacklist"
+		} else {
+			*result = "is not in blacklist"
+		}
+	} else {
+		*result = successReuslt
+	}
+	return nil
+}
+
+// get pairIDs of blacklist call for permission check
+func getBlacklistCallPairIDs(callParams []string) []string {
+	if len(callParams) == 0 {
+		return []string{allPairIDs}
+	}
+	switch callParams[0] {
+	case importBlacklistOp:
+		// imported entries may be of any pairs
+		return []string{allPairIDs}
+	case exportBlacklistOp, listBlacklistOp:
+		opts, err := parseBlacklistOptions(callParams[1:])
+		if err == nil && opts.pairID != "" {
+			return []string{opts.pairID}
+		}
+		return []string{allPairIDs}
+	}
+	if len(callParams) < 3 {
+		return []string{allPairIDs}
+	}
+	return []string{callParams[2]}
+}
+
+// the entry source is the admin caller if not specified
+func getBlacklistSource(source, caller string) string {
+	if source != "" {
+		return source
+	}
+	return caller
+}
+
+// parse 'key=value' params, only the specified keys are allowed if any
+func parseBlacklistOptions(callParams []string, allowedKeys ...string) (opts *blacklistOptions, err error) {
+	opts = &blacklistOptions{}
+	for _, param := range callParams {
+		parts := strings.SplitN(param, "=", 2)
+		if len(parts) != 2 {
+			return nil, apierrors.InvalidArgument.Errorf("wrong param '%v', must be 'key=value'", param)
+		}
+		key, value := parts[0], parts[1]
+		if len(allowedKeys) > 0 && !isInStringSlice(key, allowedKeys) {
+			return nil, apierrors.InvalidArgument.Errorf("unknown param key '%v'", key)
+		}
+		var num int64
+		switch key {
+		case "pairid":
+			opts.pairID = value
+		case "reason":
+			opts.reason = value
+		case "source":
+			opts.source = value
+		case "expire":
+			opts.expire, err = parseNumberParam(key, value)
+		case "format":
+			if value != csvFormat && value != jsonFormat {
+				return nil, apierrors.InvalidArgument.Errorf("unknown format '%v'", value)
+			}
+			opts.format = value
+		case "offset":
+			num, err = parseNumberParam(key, value)
+			opts.offset = int(num)
+		case "limit":
+			num, err = parseNumberParam(key, value)
+			opts.limit = int(num)
+		default:
+			return nil, apierrors.InvalidArgument.Errorf("unknown param key '%v'", key)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return opts, nil
+}
+
+func isInStringSlice(str string, slice []string) bool {
+	for _, item := range slice {
+		if item == str {
+			return true
+		}
+	}
+	return false
+}
+
+func importBlacklist(caller string, callParams []string, result *string) error {
+	if len(callParams) < 2 {
+		return apierrors.InvalidArgument.Errorf("wrong number of params, have %v want at least 3", len(callParams)+1)
+	}
+	format, data := callParams[0], callParams[1]
+	opts, err := parseBlacklistOptions(callParams[2:], "pairid", "reason", "source", "expire")
+	if err != nil {
+		return err
+	}
+	if len(data) > maxBlacklistImportSize {
+		return apierrors.InvalidArgument.Errorf("too large data, have %v bytes max %v", len(data), maxBlacklistImportSize)
+	}
+	entries, err := parseBlacklistImportData(format, data)
+	if err != nil {
+		return err
+	}
+	if len(entries) > maxBlacklistImport {
+		return apierrors.InvalidArgument.Errorf("too many entries, have %v max %v", len(entries), maxBlacklistImport)
+	}
+	blackAccounts := make([]*mongodb.MgoBlackAccount, 0, len(entries))
+	for i, entry := range entries {
+		mb, err := convertBlacklistImportEntry(entry, opts, caller)
+		if err != nil {
+			return apierrors.InvalidArgument.Errorf("entry %v: %v", i+1, err)
+		}
+		blackAccounts = append(blackAccounts, mb)
+	}
+	err = mongodb.ImportBlacklist(blackAccounts)
+	if err != nil {
+		return err
+	}
+	return writeBlacklistResult(&BlacklistImportResult{Imported: len(blackAccounts)}, result)
+}
+
+func parseBlacklistImportData(format, data string) (entries []*BlacklistImportEntry, err error) {
+	switch format {
+	case csvFormat:
+		entries, err = parseBlacklistCSV(data)
+	case jsonFormat:
+		err = json.Unmarshal([]byte(data), &entries)
+	default:
+		return nil, apierrors.InvalidArgument.Errorf("unknown format '%v'", format)
+	}
+	if err != nil {
+		return nil, apierrors.InvalidArgument.Errorf("parse %v data failed, %v", format, err)
+	}
+	return entries, nil
+}
+
+// replace import data with its summary (entry count, size and hash) in params
+// recorded in audit log and proposal, as the data may be too large to store
+func getBlacklistAuditParams(callParams []string) []string {
+	if len(callParams) < 3 || callParams[0] != importBlacklistOp {
+		return callParams
+	}
+	format, data := callParams[1], callParams[2]
+	summary := fmt.Sprintf("%v bytes, sha256 %x", len(data), sha256.Sum256([]byte(data)))
+	if entries, err := parseBlacklistImportData(format, data); err == nil {
+		summary = fmt.Sprintf("<%v entries, %v>", len(entries), summary)
+	} else {
+		summary = fmt.Sprintf("<%v>", summary)
+	}
+	auditParams := append([]string{}, callParams...)
+	auditParams[2] = summary
+	return auditParams
+}
+
+// the first line is the header of columns, 'address' column is required
+func parseBlacklistCSV(data string) ([]*BlacklistImportEntry, error) {
+	reader := csv.NewReader(strings.NewReader(data))
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+	header, err := reader.Read()
+	if err != nil {
+		return nil, apierrors.InvalidArgument.Wrap("read csv header failed, ", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		column = strings.ToLower(strings.TrimSpace(column))
+		if !isInStringSlice(column, blacklistCSVColumns) {
+			return nil, apierrors.InvalidArgument.Errorf("unknown column '%v'", column)
+		}
+		columns[column] = i
+	}
+	if _, exist := columns["address"]; !exist {
+		return nil, apierrors.InvalidArgument.New("missing 'address' column")
+	}
+	getColumn := func(record []string, column string) string {
+		if i, exist := columns[column]; exist {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+	var entries []*BlacklistImportEntry
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, apierrors.InvalidArgument.Wrap("read csv record failed, ", err)
+		}
+		entry := &BlacklistImportEntry{
+			Address: getColumn(record, "address"),
+			PairID:  getColumn(record, "pairid"),
+			Reason:  getColumn(record, "reason"),
+			Source:  getColumn(record, "source"),
+		}
+		if expire := getColumn(record, "expire"); expire != "" {
+			entry.Expire, err = parseNumberParam("expire", expire)
+			if err != nil {
+				return nil, apierrors.InvalidArgument.Errorf("entry %v: %v", len(entries)+1, err)
+			}
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// fill missing fields of entry with the default values of options
+func convertBlacklistImportEntry(entry *BlacklistImportEntry, opts *blacklistOptions, caller string) (*mongodb.MgoBlackAccount, error) {
+	mb := &mongodb.MgoBlackAccount{
+		Address:    strings.TrimSpace(entry.Address),
+		PairID:     strings.TrimSpace(entry.PairID),
+		Reason:     entry.Reason,
+		Source:     entry.Source,
+		ExpireTime: entry.Expire,
+	}
+	if mb.PairID == "" {
+		mb.PairID = opts.pairID
+	}
+	if mb.Reason == "" {
+		mb.Reason = opts.reason
+	}
+	if mb.Source == "" {
+		mb.Source = getBlacklistSource(opts.source, caller)
+	}
+	if mb.ExpireTime == 0 {
+		mb.ExpireTime = opts.expire
+	}
+	if !tokens.SrcBridge.IsValidAddress(mb.Address) && !tokens.DstBridge.IsValidAddress(mb.Address) {
+		return nil, apierrors.InvalidArgument.Errorf("invalid address '%v'", mb.Address)
+	}
+	if mb.PairID == "" {
+		return nil, apierrors.InvalidArgument.Errorf("missing pairID of address '%v'", mb.Address)
+	}
+	if !strings.EqualFold(mb.PairID, allPairIDs) && !tokens.IsTokenPairExist(mb.PairID) {
+		return nil, apierrors.InvalidArgument.Errorf("unknown pairID '%v'", mb.PairID)
+	}
+	return mb, nil
+}
+
+func exportBlacklist(callParams []string, result *string) error {
+	opts, err := parseBlacklistOptions(callParams, "pairid", "format")
+	if err != nil {
+		return err
+	}
+	_, entries, err := mongodb.FindBlacklist(opts.pairID, 0, 0)
+	if err != nil {
+		return err
+	}
+	if opts.format != csvFormat {
+		return writeBlacklistResult(entries, result)
+	}
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	_ = writer.Write(append(blacklistCSVColumns, "timestamp"))
+	for _, mb := range entries {
+		_ = writer.Write([]string{
+			mb.Address,
+			mb.PairID,
+			mb.Reason,
+			mb.Source,
+			fmt.Sprint(mb.ExpireTime),
+			fmt.Sprint(mb.Timestamp),
+		})
+	}
+	writer.Flush()
+	if err = writer.Error(); err != nil {
+		return err
+	}
+	*result = buf.String()
+	return nil
+}
+
+func listBlacklist(callParams []string, result *string) error {
+	opts, err := parseBlacklistOptions(callParams, "pairid", "offset", "limit")
+	if err != nil {
+		return err
+	}
+	if opts.limit <= 0 {
+		opts.limit = defaultBlacklistLimit
+	} else if opts.limit > maxBlacklistLimit {
+		opts.limit = maxBlacklistLimit
+	}
+	total, entries, err := mongodb.FindBlacklist(opts.pairID, opts.offset, opts.limit)
+	if err != nil {
+		return err
+	}
+	return writeBlacklistResult(&BlacklistListResult{
+		Total:   total,
+		Offset:  opts.offset,
+		Entries: entries,
+	}, result)
+}
+
+func writeBlacklistResult(res interface{}, result *string) error {
+	data, err := json.Marshal(res)
+	if err != nil {
+		return err
+	}
+	*result = string(data)
+	return nil
+}
@@ -0,0 +1,55 @@
+package rpcapi
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseBlacklistCSV(t *testing.T) {
+	tests := []struct {
+		data    string
+		entries []*BlacklistImportEntry
+		isErr   bool
+	}{
+		{
+			data: "address,pairid,reason,source,expire\n" +
+				"0x1111,eth,hacker,chainalysis,1600000000\n" +
+				"# comment line\n" +
+				"0x2222, all, phishing, ,\n",
+			entries: []*BlacklistImportEntry{
+				{Address: "0x1111", PairID: "eth", Reason: "hacker", Source: "chainalysis", Expire: 1600000000},
+				{Address: "0x2222", PairID: "all", Reason: "phishing"},
+			},
+		},
+		{
+			data: "Reason, Address\n" +
+				"stolen,0x3333\n",
+			entries: []*BlacklistImportEntry{
+				{Address: "0x3333", Reason: "stolen"},
+			},
+		},
+		{data: "address\n", entries: nil},
+		{data: "", isErr: true},
+		{data: "pairid,reason\neth,hacker\n", isErr: true},
+		{data: "address,unknown\n0x1111,1\n", isErr: true},
+		{data: "address,pairid\n0x1111\n", isErr: true},
+		{data: "address,expire\n0x1111,-1\n", isErr: true},
+		{data: "address,expire\n0x1111,never\n", isErr: true},
+	}
+	for i, test := range tests {
+		entries, err := parseBlacklistCSV(test.data)
+		if test.isErr {
+			if err == nil {
+				t.Errorf("test %d: parseBlacklistCSV expect error, got %v entries", i, len(entries))
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("test %d: parseBlacklistCSV error: %v", i, err)
+			continue
+		}
+		if !reflect.DeepEqual(entries, test.entries) {
+			t.Errorf("test %d: parseBlacklistCSV = %+v, want %+v", i, entries, test.entries)
+		}
+	}
+}
@@ -0,0 +1,294 @@
+package rpcapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/anyswap/CrossChain-Bridge/admin"
+	"github.com/anyswap/CrossChain-Bridge/log"
+	"github.com/anyswap/CrossChain-Bridge/mongodb"
+	"github.com/anyswap/CrossChain-Bridge/rpc/apierrors"
+)
+
+const (
+	bulkMethod = "bulk"
+
+	bulkDryRunOp  = "dryrun"
+	bulkExecuteOp = "execute"
+	bulkStatusOp  = "status"
+
+	defaultBulkLimit     = 1000
+	maxBulkLimit         = 5000
+	bulkSampleCount      = 10
+	bulkMaxErrors        = 100
+	bulkProgressInterval = 20
+)
+
+// admin methods supported by bulk call and their operations
+var bulkMethodOperations = map[string][]string{
+	"reverify": {swapinOp, swapoutOp},
+	"reswap":   {swapinOp, swapoutOp},
+	"manual":   {passSwapinOp, failSwapinOp, passSwapoutOp, failSwapoutOp},
+	"bigvalue": {passSwapinOp, passSwapoutOp},
+}
+
+type bulkCall struct {
+	method    string
+	operation string
+	isSwapin  bool
+	filter    *mongodb.SwapFilter
+	limit     int
+	memo      string
+	forceOpt  string
+}
+
+type bulkDryRunResult struct {
+	Count   int                `json:"count"`
+	Limit   int                `json:"limit"`
+	Samples []*mongodb.MgoSwap `json:"samples"`
+}
+
+// get the admin method and operation bulk call operates with,
+// ok is false if it is not a dryrun or execute bulk call
+func getBulkCallMethod(callParams []string) (method, operation string, ok bool) {
+	if len(callParams) < 3 {
+		return "", "", false
+	}
+	switch callParams[0] {
+	case bulkDryRunOp, bulkExecuteOp:
+		return callParams[1], callParams[2], true
+	default:
+		return "", "", false
+	}
+}
+
+// get pairIDs of bulk call from its parsed 'pairid' filter,
+// so that the permission is checked on the pairID the call operates on
+func getBulkCallPairIDs(callParams []string) []string {
+	if _, _, ok := getBulkCallMethod(callParams); !ok {
+		return nil
+	}
+	call, err := parseBulkCall(callParams)
+	if err != nil || call.filter.PairID == "" {
+		return []string{allPairIDs}
+	}
+	return []string{call.filter.PairID}
+}
+
+// bulk reverify, reswap, manual or bigvalue swaps matched by filter,
+// params are '<dryrun|execute> <method> <operation> [key=value]...' or 'status <jobID>'.
+// filter keys are 'pairid', 'bind', 'status' (comma separated),
+// 'from' and 'to' (unix seconds of swap register time) and 'limit',
+// and 'memo' of manual and 'force' of reswap are passed to every call.
+func bulk(caller string, args *admin.CallArgs, result *string) error {
+	if len(args.Params) == 0 {
+		return apierrors.InvalidArgument.New("wrong number of params, have 0 want at least 1")
+	}
+	operation := args.Params[0]
+	switch operation {
+	case bulkStatusOp:
+		return bulkStatus(args, result)
+	case bulkDryRunOp, bulkExecuteOp:
+	default:
+		return apierrors.InvalidArgument.Errorf("unknown operation '%v'", operation)
+	}
+	call, err := parseBulkCall(args.Params)
+	if err != nil {
+		return err
+	}
+	if operation == bulkDryRunOp {
+		return bulkDryRun(call, result)
+	}
+	return bulkExecute(caller, args, call, result)
+}
+
+// nolint:gocyclo // allow big simple params parsing
+func parseBulkCall(callParams []string) (*bulkCall, error) {
+	if len(callParams) < 3 {
+		return nil, apierrors.InvalidArgument.Errorf("wrong number of params, have %v want at least 3", len(callParams))
+	}
+	call := &bulkCall{
+		method:    callParams[1],
+		operation: callParams[2],
+		filter:    &mongodb.SwapFilter{},
+		limit:     defaultBulkLimit,
+	}
+	ops, exist := bulkMethodOperations[call.method]
+	if !exist {
+		return nil, apierrors.InvalidArgument.Errorf("unsupported bulk method '%v'", call.method)
+	}
+	isValidOp := false
+	for _, op := range ops {
+		if op == call.operation {
+			isValidOp = true
+			break
+		}
+	}
+	if !isValidOp {
+		return nil, apierrors.InvalidArgument.Errorf("unknown operation '%v' of method '%v'", call.operation, call.method)
+	}
+	call.isSwapin = strings.HasSuffix(call.operation, swapinOp)
+
+	keys := make(map[string]struct{})
+	for _, param := range callParams[3:] {
+		parts := strings.SplitN(param, "=", 2)
+		if len(parts) != 2 {
+			return nil, apierrors.InvalidArgument.Errorf("wrong param '%v', must be 'key=value'", param)
+		}
+		key, value := parts[0], parts[1]
+		if _, exist := keys[key]; exist {
+			return nil, apierrors.InvalidArgument.Errorf("repeated param key '%v'", key)
+		}
+		keys[key] = struct{}{}
+		var num int64
+		var err error
+		switch key {
+		case "pairid":
+			call.filter.PairID = value
+		case "bind":
+			call.filter.Bind = value
+		case "status":
+			for _, s := range strings.Split(value, ",") {
+				num, err = parseNumberParam(key, s)
+				if err != nil {
+					return nil, err
+				}
+				call.filter.Statuses = append(call.filter.Statuses, mongodb.SwapStatus(num))
+			}
+		case "from":
+			num, err = parseNumberParam(key, value)
+			call.filter.InitTimeFrom = num * 1000
+		case "to":
+			num, err = parseNumberParam(key, value)
+			call.filter.InitTimeTo = num * 1000
+		case "limit":
+			num, err = parseNumberParam(key, value)
+			call.limit = int(num)
+		case "memo":
+			if call.method != "manual" {
+				return nil, apierrors.InvalidArgument.Errorf("param 'memo' is only supported by method 'manual'")
+			}
+			call.memo = value
+		case "force":
+			if call.method != "reswap" {
+				return nil, apierrors.InvalidArgument.Errorf("param 'force' is only supported by method 'reswap'")
+			}
+			if value == "true" {
+				call.forceOpt = forceFlag
+			}
+		default:
+			return nil, apierrors.InvalidArgument.Errorf("unknown param key '%v'", key)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if call.limit <= 0 {
+		call.limit = defaultBulkLimit
+	} else if call.limit > maxBulkLimit {
+		call.limit = maxBulkLimit
+	}
+	return call, nil
+}
+
+// the params of admin method to call on a swap
+func (call *bulkCall) getCallParams(swap *mongodb.MgoSwap) []string {
+	callParams := []string{call.operation, swap.TxID, swap.PairID, swap.Bind}
+	switch {
+	case call.memo != "":
+		callParams = append(callParams, call.memo)
+	case call.forceOpt != "":
+		callParams = append(callParams, call.forceOpt)
+	}
+	return callParams
+}
+
+func bulkDryRun(call *bulkCall, result *string) error {
+	count, err := mongodb.GetCountOfSwapsWithFilter(call.isSwapin, call.filter)
+	if err != nil {
+		return err
+	}
+	samples, err := mongodb.FindSwapsWithFilter(call.isSwapin, call.filter, bulkSampleCount)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(&bulkDryRunResult{
+		Count:   count,
+		Limit:   call.limit,
+		Samples: samples,
+	})
+	if err != nil {
+		return err
+	}
+	*result = string(data)
+	return nil
+}
+
+func bulkExecute(caller string, args *admin.CallArgs, call *bulkCall, result *string) error {
+	// snapshot the matched swaps, as their status is changed by processing
+	swaps, err := mongodb.FindSwapsWithFilter(call.isSwapin, call.filter, call.limit)
+	if err != nil {
+		return err
+	}
+	if len(swaps) == 0 {
+		return apierrors.InvalidArgument.New("no swap matches the filter")
+	}
+	job := &mongodb.MgoBulkJob{
+		Method: args.Method,
+		Params: args.Params,
+		Caller: strings.ToLower(caller),
+		Total:  len(swaps),
+	}
+	err = mongodb.AddBulkJob(job)
+	if err != nil {
+		return err
+	}
+	go runBulkJob(job, call, swaps)
+	*result = fmt.Sprintf("bulk job %v is started, total %v swaps", job.Key, job.Total)
+	return nil
+}
+
+func runBulkJob(job *mongodb.MgoBulkJob, call *bulkCall, swaps []*mongodb.MgoSwap) {
+	log.Info("[bulk] start bulk job", "id", job.Key, "method", call.method, "operation", call.operation, "total", job.Total, "caller", job.Caller)
+	for _, swap := range swaps {
+		args := &admin.CallArgs{
+			Method: call.method,
+			Params: call.getCallParams(swap),
+		}
+		var callResult string
+		err := doCall(job.Caller, args, &callResult)
+		job.Processed++
+		if err == nil {
+			job.Succeeded++
+		} else {
+			job.Failed++
+			log.Info("[bulk] process swap failed", "id", job.Key, "method", args.Method, "params", args.Params, "err", err)
+			if len(job.Errors) < bulkMaxErrors {
+				job.Errors = append(job.Errors, fmt.Sprintf("%v %v %v: %v", swap.TxID, swap.PairID, swap.Bind, err))
+			}
+		}
+		if job.Processed%bulkProgressInterval == 0 && job.Processed < job.Total {
+			_ = mongodb.UpdateBulkJobProgress(job)
+		}
+	}
+	job.Status = mongodb.BulkJobFinished
+	_ = mongodb.UpdateBulkJobProgress(job)
+	log.Info("[bulk] finish bulk job", "id", job.Key, "total", job.Total, "succeeded", job.Succeeded, "failed", job.Failed)
+}
+
+func bulkStatus(args *admin.CallArgs, result *string) error {
+	if len(args.Params) != 2 {
+		return apierrors.InvalidArgument.Errorf("wrong number of params, have %v want 2", len(args.Params))
+	}
+	job, err := mongodb.FindBulkJob(args.Params[1])
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(job)
+	if err != nil {
+		return err
+	}
+	*result = string(data)
+	return nil
+}
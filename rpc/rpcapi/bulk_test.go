@@ -0,0 +1,102 @@
+package rpcapi
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/anyswap/CrossChain-Bridge/mongodb"
+)
+
+func TestParseBulkCall(t *testing.T) {
+	tests := []struct {
+		params []string
+		call   *bulkCall
+		isErr  bool
+	}{
+		{
+			params: []string{"dryrun", "reverify", "swapin"},
+			call: &bulkCall{
+				method:    "reverify",
+				operation: "swapin",
+				isSwapin:  true,
+				filter:    &mongodb.SwapFilter{},
+				limit:     defaultBulkLimit,
+			},
+		},
+		{
+			params: []string{"execute", "reswap", "swapout", "pairid=eth", "bind=0x1234", "status=1,2", "from=1600000000", "to=1600003600", "limit=100", "force=true"},
+			call: &bulkCall{
+				method:    "reswap",
+				operation: "swapout",
+				filter: &mongodb.SwapFilter{
+					PairID:       "eth",
+					Bind:         "0x1234",
+					Statuses:     []mongodb.SwapStatus{1, 2},
+					InitTimeFrom: 1600000000000,
+					InitTimeTo:   1600003600000,
+				},
+				limit:    100,
+				forceOpt: forceFlag,
+			},
+		},
+		{
+			params: []string{"execute", "manual", "passswapin", "memo=checked", "limit=10000"},
+			call: &bulkCall{
+				method:    "manual",
+				operation: "passswapin",
+				isSwapin:  true,
+				filter:    &mongodb.SwapFilter{},
+				limit:     maxBulkLimit,
+				memo:      "checked",
+			},
+		},
+		{params: []string{"dryrun", "reverify"}, isErr: true},
+		{params: []string{"dryrun", "unknown", "swapin"}, isErr: true},
+		{params: []string{"dryrun", "bigvalue", "failswapin"}, isErr: true},
+		{params: []string{"dryrun", "reverify", "swapin", "pairid"}, isErr: true},
+		{params: []string{"dryrun", "reverify", "swapin", "pairid=eth", "pairid=btc"}, isErr: true},
+		{params: []string{"dryrun", "reverify", "swapin", "unknown=1"}, isErr: true},
+		{params: []string{"dryrun", "reverify", "swapin", "status=1,a"}, isErr: true},
+		{params: []string{"dryrun", "reverify", "swapin", "from=-1"}, isErr: true},
+		{params: []string{"dryrun", "reverify", "swapin", "memo=checked"}, isErr: true},
+		{params: []string{"dryrun", "manual", "passswapin", "force=true"}, isErr: true},
+	}
+	for i, test := range tests {
+		call, err := parseBulkCall(test.params)
+		if test.isErr {
+			if err == nil {
+				t.Errorf("test %d: parseBulkCall(%v) expect error, got %+v", i, test.params, call)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("test %d: parseBulkCall(%v) error: %v", i, test.params, err)
+			continue
+		}
+		if !reflect.DeepEqual(call, test.call) {
+			t.Errorf("test %d: parseBulkCall(%v) = %+v, want %+v", i, test.params, call, test.call)
+		}
+	}
+}
+
+func TestGetBulkCallPairIDs(t *testing.T) {
+	tests := []struct {
+		params  []string
+		pairIDs []string
+	}{
+		{[]string{"status", "jobid"}, nil},
+		{[]string{"dryrun", "reverify"}, nil},
+		{[]string{"dryrun", "reverify", "swapin"}, []string{"all"}},
+		{[]string{"dryrun", "reverify", "swapin", "pairid=eth"}, []string{"eth"}},
+		{[]string{"execute", "reswap", "swapout", "bind=0x1234", "pairid=fsn"}, []string{"fsn"}},
+		{[]string{"execute", "reswap", "swapout", "pairid="}, []string{"all"}},
+		{[]string{"execute", "reswap", "swapout", "pairid=eth", "pairid=btc"}, []string{"all"}},
+		{[]string{"execute", "reswap", "swapout", "pairid=eth", "unknown=1"}, []string{"all"}},
+	}
+	for i, test := range tests {
+		pairIDs := getBulkCallPairIDs(test.params)
+		if !reflect.DeepEqual(pairIDs, test.pairIDs) {
+			t.Errorf("test %d: getBulkCallPairIDs(%v) = %v, want %v", i, test.params, pairIDs, test.pairIDs)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package rpcapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/anyswap/CrossChain-Bridge/admin"
+	"github.com/anyswap/CrossChain-Bridge/mongodb"
+	"github.com/anyswap/CrossChain-Bridge/rpc/apierrors"
+	"github.com/anyswap/CrossChain-Bridge/tokens"
+	"github.com/anyswap/CrossChain-Bridge/worker"
+)
+
+const (
+	maintenanceMethod = "maintenance"
+
+	addMaintenanceOp    = "add"
+	removeMaintenanceOp = "remove"
+	listMaintenanceOp   = "list"
+)
+
+// maintenance manage scheduled maintenance windows, params are
+// 'add <deposit|withdraw|both> <pairIDs> <start> <end> [reason]' (unix seconds),
+// 'remove <windowID>' or 'list'
+func maintenance(caller string, args *admin.CallArgs, result *string) error {
+	if len(args.Params) == 0 {
+		return apierrors.InvalidArgument.New("wrong number of params, have 0 want at least 1")
+	}
+	switch operation := args.Params[0]; operation {
+	case addMaintenanceOp:
+		return addMaintenance(caller, args, result)
+	case removeMaintenanceOp:
+		if len(args.Params) != 2 {
+			return apierrors.InvalidArgument.Errorf("wrong number of params, have %v want 2", len(args.Params))
+		}
+		err := mongodb.RemoveMaintenanceWindow(args.Params[1])
+		if err != nil {
+			return err
+		}
+		worker.LoadMaintenanceWindows()
+		*result = successReuslt
+		return nil
+	case listMaintenanceOp:
+		if len(args.Params) != 1 {
+			return apierrors.InvalidArgument.Errorf("wrong number of params, have %v want 1", len(args.Params))
+		}
+		windows, err := mongodb.FindMaintenanceWindows(time.Now().Unix())
+		if err != nil {
+			return err
+		}
+		data, err := json.Marshal(windows)
+		if err != nil {
+			return err
+		}
+		*result = string(data)
+		return nil
+	default:
+		return apierrors.InvalidArgument.Errorf("unknown operation '%v'", operation)
+	}
+}
+
+func addMaintenance(caller string, args *admin.CallArgs, result *string) error {
+	if !(len(args.Params) == 5 || len(args.Params) == 6) {
+		return apierrors.InvalidArgument.Errorf("wrong number of params, have %v want 5 or 6", len(args.Params))
+	}
+	direction := args.Params[1]
+	pairIDs := args.Params[2]
+	switch direction {
+	case "deposit", "withdraw", "both":
+	default:
+		return apierrors.InvalidArgument.Errorf("unknown direction '%v'", direction)
+	}
+	startTime, err := parseNumberParam("start", args.Params[3])
+	if err != nil {
+		return err
+	}
+	endTime, err := parseNumberParam("end", args.Params[4])
+	if err != nil {
+		return err
+	}
+	if endTime <= startTime {
+		return apierrors.InvalidArgument.New("end time must be after start time")
+	}
+	if endTime <= time.Now().Unix() {
+		return apierrors.InvalidArgument.New("end time is already passed")
+	}
+	var reason string
+	if len(args.Params) > 5 {
+		reason = args.Params[5]
+	}
+
+	var pairIDSlice []string
+	if strings.EqualFold(pairIDs, allPairIDs) {
+		pairIDSlice = tokens.GetAllPairIDs()
+	} else {
+		pairIDSlice = strings.Split(pairIDs, ",")
+		for _, pairID := range pairIDSlice {
+			if !tokens.IsTokenPairExist(pairID) {
+				return apierrors.InvalidArgument.Errorf("token pair '%v' not exist", pairID)
+			}
+		}
+	}
+
+	windowIDs := make([]string, 0, len(pairIDSlice))
+	for _, pairID := range pairIDSlice {
+		mw := &mongodb.MgoMaintenanceWindow{
+			PairID:    pairID,
+			Direction: direction,
+			StartTime: startTime,
+			EndTime:   endTime,
+			Reason:    reason,
+			CreatedBy: caller,
+		}
+		err = mongodb.AddMaintenanceWindow(mw)
+		if err != nil {
+			return err
+		}
+		windowIDs = append(windowIDs, mw.Key)
+	}
+	worker.LoadMaintenanceWindows()
+	*result = fmt.Sprintf("%v, window IDs: %v", successReuslt, strings.Join(windowIDs, ","))
+	return nil
+}
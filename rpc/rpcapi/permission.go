@@ -0,0 +1,62 @@
+package rpcapi
+
+import (
+	"strings"
+
+	"github.com/anyswap/CrossChain-Bridge/internal/metrics"
+	"github.com/anyswap/CrossChain-Bridge/log"
+	"github.com/anyswap/CrossChain-Bridge/params"
+	"github.com/anyswap/CrossChain-Bridge/rpc/apierrors"
+)
+
+const allPairIDs = "all"
+
+// get pairIDs the admin call operates on, nil if the method is not pair specific
+func getCallPairIDs(method string, callParams []string) []string {
+	switch method {
+	case "dcrmhealth", "migration", "audit":
+		return nil
+	case "addpair", "updatepair":
+		return []string{allPairIDs}
+	case "removepair":
+		if len(callParams) > 0 {
+			return []string{callParams[0]}
+		}
+	case bulkMethod:
+		return getBulkCallPairIDs(callParams)
+	case blacklistMethod:
+		return getBlacklistCallPairIDs(callParams)
+	case maintenanceMethod:
+		if len(callParams) > 0 && callParams[0] == listMaintenanceOp {
+			return nil
+		}
+	}
+	// pairID (or comma separated pairIDs of maintain) is the third param of the pair specific methods
+	if len(callParams) < 3 {
+		return []string{allPairIDs}
+	}
+	return strings.Split(callParams[2], ",")
+}
+
+func checkAdminPermission(sender, method string, callParams []string) error {
+	var operation string
+	if len(callParams) > 0 {
+		operation = callParams[0]
+	}
+	pairIDs := getCallPairIDs(method, callParams)
+	// bulk call requires permission of the admin method it operates with
+	if method == bulkMethod {
+		if bulkCallMethod, bulkCallOp, ok := getBulkCallMethod(callParams); ok {
+			method, operation = bulkCallMethod, bulkCallOp
+		}
+	}
+	if params.IsAdminMethodAllowed(sender, method, operation, pairIDs) {
+		return nil
+	}
+	log.Warn("[admin] deny admin call without permission", "sender", sender, "method", method, "params", callParams)
+	metrics.IncAdminDeniedCall(method)
+	if len(pairIDs) != 0 {
+		return apierrors.Forbidden.Errorf("sender %v has no permission to call admin method '%v' with operation '%v' on pairs %v", sender, method, operation, pairIDs)
+	}
+	return apierrors.Forbidden.Errorf("sender %v has no permission to call admin method '%v' with operation '%v'", sender, method, operation)
+}
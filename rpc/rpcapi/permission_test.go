@@ -0,0 +1,86 @@
+package rpcapi
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/anyswap/CrossChain-Bridge/params"
+)
+
+const (
+	testOperator = "0x2222222222222222222222222222222222222222"
+)
+
+func TestGetCallPairIDs(t *testing.T) {
+	tests := []struct {
+		method  string
+		params  []string
+		pairIDs []string
+	}{
+		{"dcrmhealth", []string{"list"}, nil},
+		{"audit", []string{"list", "method=reswap"}, nil},
+		{"addpair", []string{"eth", "config.toml"}, []string{"all"}},
+		{"updatepair", []string{"eth"}, []string{"all"}},
+		{"removepair", []string{"eth"}, []string{"eth"}},
+		{"removepair", nil, []string{"all"}},
+		{"reverify", []string{"swapin", "0x1234", "eth"}, []string{"eth"}},
+		{"reverify", []string{"swapin", "0x1234"}, []string{"all"}},
+		{"maintain", []string{"close", "deposit", "eth,fsn"}, []string{"eth", "fsn"}},
+		{"maintenance", []string{"list"}, nil},
+		{"maintenance", []string{"add", "both", "eth", "1600000000", "1600003600"}, []string{"eth"}},
+		{"blacklist", []string{"add", "0x1234", "eth"}, []string{"eth"}},
+		{"blacklist", []string{"add", "0x1234"}, []string{"all"}},
+		{"blacklist", []string{"import", "data"}, []string{"all"}},
+		{"blacklist", []string{"list", "pairid=eth"}, []string{"eth"}},
+		{"blacklist", []string{"list"}, []string{"all"}},
+		{"bulk", []string{"status", "jobid"}, nil},
+		{"bulk", []string{"dryrun", "reverify", "swapin", "pairid=eth"}, []string{"eth"}},
+		{"bulk", []string{"execute", "reverify", "swapin"}, []string{"all"}},
+		{"bulk", []string{"execute", "reverify", "swapin", "pairid=eth", "pairid=btc"}, []string{"all"}},
+	}
+	for i, test := range tests {
+		pairIDs := getCallPairIDs(test.method, test.params)
+		if !reflect.DeepEqual(pairIDs, test.pairIDs) {
+			t.Errorf("test %d: getCallPairIDs(%v, %v) = %v, want %v", i, test.method, test.params, pairIDs, test.pairIDs)
+		}
+	}
+}
+
+func TestCheckAdminPermission(t *testing.T) {
+	oldConfig := params.GetConfig()
+	defer params.SetConfig(oldConfig)
+	params.SetConfig(&params.ServerConfig{
+		AdminRoles: map[string]*params.AdminRole{
+			"operator": {
+				Members: []string{testOperator},
+				Methods: []string{"reverify", "manual:passswapin"},
+				PairIDs: []string{"eth"},
+			},
+		},
+	})
+	tests := []struct {
+		method  string
+		params  []string
+		allowed bool
+	}{
+		{"reverify", []string{"swapin", "0x1234", "eth"}, true},
+		{"reverify", []string{"swapin", "0x1234", "btc"}, false},
+		{"reswap", []string{"swapin", "0x1234", "eth"}, false},
+		{"manual", []string{"passswapin", "0x1234", "eth"}, true},
+		{"manual", []string{"failswapin", "0x1234", "eth"}, false},
+		{"bulk", []string{"dryrun", "reverify", "swapin", "pairid=eth"}, true},
+		{"bulk", []string{"execute", "manual", "passswapin", "pairid=eth", "memo=test"}, true},
+		{"bulk", []string{"execute", "manual", "failswapin", "pairid=eth"}, false},
+		{"bulk", []string{"execute", "reverify", "swapin"}, false},
+		{"bulk", []string{"execute", "reverify", "swapin", "pairid=btc"}, false},
+		// repeated key must not pass the check with one pairID and operate on another
+		{"bulk", []string{"execute", "reverify", "swapin", "pairid=eth", "pairid=btc"}, false},
+		{"bulk", []string{"execute", "reverify", "swapin", "pairid=btc", "pairid=eth"}, false},
+	}
+	for i, test := range tests {
+		err := checkAdminPermission(testOperator, test.method, test.params)
+		if allowed := err == nil; allowed != test.allowed {
+			t.Errorf("test %d: checkAdminPermission(%v, %v) allowed = %v, want %v, err = %v", i, test.method, test.params, allowed, test.allowed, err)
+		}
+	}
+}
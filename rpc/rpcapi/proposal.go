@@ -0,0 +1,231 @@
+package rpcapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anyswap/CrossChain-Bridge/admin"
+	"github.com/anyswap/CrossChain-Bridge/log"
+	"github.com/anyswap/CrossChain-Bridge/mongodb"
+	"github.com/anyswap/CrossChain-Bridge/params"
+	"github.com/anyswap/CrossChain-Bridge/rpc/apierrors"
+)
+
+const (
+	listProposalsOp   = "list"
+	approveProposalOp = "approve"
+	cancelProposalOp  = "cancel"
+
+	maxListProposals = 100
+)
+
+// serialize proposal calls in this process, proposals are also approved
+// and claimed atomically in database as there may be several servers.
+var proposalLock sync.Mutex
+
+func getApprovalThreshold(args *admin.CallArgs) int {
+	var operation string
+	if len(args.Params) > 0 {
+		operation = args.Params[0]
+	}
+	// bulk execution requires approvals of the admin method it operates with
+	if args.Method == bulkMethod {
+		method, bulkCallOp, ok := getBulkCallMethod(args.Params)
+		if !ok || operation != bulkExecuteOp {
+			return 1
+		}
+		return params.GetAdminApprovalThreshold(method, bulkCallOp)
+	}
+	return params.GetAdminApprovalThreshold(args.Method, operation)
+}
+
+func propose(proposalID, proposer string, args *admin.CallArgs, threshold int, result *string) error {
+	mp := &mongodb.MgoAdminProposal{
+		Key:        proposalID,
+		Method:     args.Method,
+		Params:     getAuditParams(args), // only for display, call params are decoded from raw tx
+		Proposer:   strings.ToLower(proposer),
+		Approvers:  []string{strings.ToLower(proposer)},
+		Threshold:  threshold,
+		ExpireTime: time.Now().Unix() + params.GetAdminProposalLifetime(),
+		RawTx:      args.RawTx,
+	}
+	err := mongodb.AddAdminProposal(mp)
+	if err != nil {
+		return err
+	}
+	*result = fmt.Sprintf("proposal %v is created, approvals 1/%v", proposalID, threshold)
+	return nil
+}
+
+func proposals(sender string, args *admin.CallArgs, result *string) (err error) {
+	if len(args.Params) == 0 {
+		return apierrors.InvalidArgument.New("wrong number of params, have 0 want at least 1")
+	}
+	operation := args.Params[0]
+	switch operation {
+	case listProposalsOp:
+		return listProposals(args, result)
+	case approveProposalOp, cancelProposalOp:
+	default:
+		return apierrors.InvalidArgument.Errorf("unknown operation '%v'", operation)
+	}
+	if len(args.Params) != 2 {
+		return apierrors.InvalidArgument.Errorf("wrong number of params, have %v want 2", len(args.Params))
+	}
+	proposalID := args.Params[1]
+
+	proposalLock.Lock()
+	defer proposalLock.Unlock()
+
+	proposal, err := getPendingProposal(proposalID)
+	if err != nil {
+		return err
+	}
+	callParams, err := getProposalCallParams(proposal)
+	if err != nil {
+		return err
+	}
+	err = checkAdminPermission(sender, proposal.Method, callParams)
+	if err != nil {
+		return err
+	}
+	sender = strings.ToLower(sender)
+	if operation == cancelProposalOp {
+		err = mongodb.UpdateAdminProposalStatus(proposalID, mongodb.ProposalCancelled, "cancelled by "+sender)
+		if err != nil {
+			return err
+		}
+		*result = successReuslt
+		return nil
+	}
+	return approveProposal(proposal, callParams, sender, args.RawTx, result)
+}
+
+// get call params from the admin tx of proposer, as params stored in proposal may be summarized
+func getProposalCallParams(proposal *mongodb.MgoAdminProposal) ([]string, error) {
+	tx, err := admin.DecodeTransaction(proposal.RawTx)
+	if err != nil {
+		return nil, err
+	}
+	_, callArgs, err := admin.VerifySignature(tx)
+	if err != nil {
+		return nil, err
+	}
+	if callArgs.Method != proposal.Method {
+		return nil, apierrors.InvalidArgument.Errorf("proposal %v method mismatch with its admin tx", proposal.Key)
+	}
+	return callArgs.Params, nil
+}
+
+func getPendingProposal(proposalID string) (*mongodb.MgoAdminProposal, error) {
+	proposal, err := mongodb.FindAdminProposal(proposalID)
+	if err != nil {
+		return nil, err
+	}
+	if proposal.Status != mongodb.ProposalPending {
+		return nil, apierrors.InvalidArgument.Errorf("proposal %v is %v", proposalID, proposal.Status)
+	}
+	if time.Now().Unix() > proposal.ExpireTime {
+		_ = mongodb.UpdateAdminProposalStatus(proposalID, mongodb.ProposalExpired, "")
+		return nil, apierrors.InvalidArgument.Errorf("proposal %v is %v", proposalID, mongodb.ProposalExpired)
+	}
+	return proposal, nil
+}
+
+// the approve tx is kept in proposal, so that the approvals can be proven
+// to others (eg. oracles verifying manual payout)
+func approveProposal(proposal *mongodb.MgoAdminProposal, callParams []string, approver, approveTx string, result *string) error {
+	proposalID := proposal.Key
+	for _, addr := range proposal.Approvers {
+		if addr == approver {
+			return apierrors.InvalidArgument.Errorf("proposal %v is already approved by %v", proposalID, approver)
+		}
+	}
+	proposal, err := mongodb.AddAdminProposalApprover(proposalID, approver, approveTx)
+	if err == mongodb.ErrItemNotFound {
+		return apierrors.InvalidArgument.Errorf("proposal %v is not pending or already approved by %v", proposalID, approver)
+	}
+	if err != nil {
+		return err
+	}
+	approvals := len(proposal.Approvers)
+	if approvals < proposal.Threshold {
+		*result = fmt.Sprintf("proposal %v is approved, approvals %v/%v", proposalID, approvals, proposal.Threshold)
+		return nil
+	}
+	// claim proposal before executing, as approvals may reach threshold concurrently
+	err = mongodb.ClaimAdminProposal(proposalID)
+	if err == mongodb.ErrItemNotFound {
+		*result = fmt.Sprintf("proposal %v is approved and executed by others, approvals %v/%v", proposalID, approvals, proposal.Threshold)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	log.Info("[admin] execute proposal", "id", proposalID, "method", proposal.Method, "params", proposal.Params, "approvals", approvals)
+	args := &admin.CallArgs{
+		Method:     proposal.Method,
+		Params:     callParams,
+		Timestamp:  time.Now().Unix(),
+		RawTx:      proposal.RawTx,
+		ApproveTxs: proposal.ApproveTxs,
+	}
+	var callResult string
+	callErr := doCall(strings.Join(proposal.Approvers, ","), args, &callResult)
+	if callErr != nil {
+		_ = mongodb.FinishAdminProposal(proposalID, mongodb.ProposalFailed, callErr.Error())
+		return callErr
+	}
+	_ = mongodb.FinishAdminProposal(proposalID, mongodb.ProposalExecuted, callResult)
+	*result = callResult
+	return nil
+}
+
+func listProposals(args *admin.CallArgs, result *string) error {
+	if len(args.Params) > 2 {
+		return apierrors.InvalidArgument.Errorf("wrong number of params, have %v want 1 or 2", len(args.Params))
+	}
+	status := mongodb.ProposalPending
+	if len(args.Params) > 1 {
+		status = args.Params[1]
+		switch status {
+		case "all":
+			status = ""
+		case mongodb.ProposalPending,
+			mongodb.ProposalExecuting,
+			mongodb.ProposalExecuted,
+			mongodb.ProposalFailed,
+			mongodb.ProposalCancelled,
+			mongodb.ProposalExpired:
+		default:
+			return apierrors.InvalidArgument.Errorf("unknown proposal status '%v'", status)
+		}
+	}
+	list, err := mongodb.FindAdminProposals(status, maxListProposals)
+	if err != nil {
+		return err
+	}
+	now := time.Now().Unix()
+	items := make([]*mongodb.MgoAdminProposal, 0, len(list))
+	for _, proposal := range list {
+		if proposal.Status == mongodb.ProposalPending && now > proposal.ExpireTime {
+			_ = mongodb.UpdateAdminProposalStatus(proposal.Key, mongodb.ProposalExpired, "")
+			if status == mongodb.ProposalPending {
+				continue
+			}
+			proposal.Status = mongodb.ProposalExpired
+		}
+		items = append(items, proposal)
+	}
+	data, err := json.Marshal(items)
+	if err != nil {
+		return err
+	}
+	*result = string(data)
+	return nil
+}
@@ -0,0 +1,33 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/gorilla/rpc/v2"
+	rpcjson "github.com/gorilla/rpc/v2/json2"
+
+	"github.com/anyswap/CrossChain-Bridge/rpc/apierrors"
+)
+
+// errorCodec json rpc codec which converts errors to api errors in the catalogue
+type errorCodec struct {
+	*rpcjson.Codec
+}
+
+func newErrorCodec() *errorCodec {
+	return &errorCodec{Codec: rpcjson.NewCodec()}
+}
+
+// NewRequest impl rpc.Codec
+func (c *errorCodec) NewRequest(r *http.Request) rpc.CodecRequest {
+	return &errorCodecRequest{CodecRequest: c.Codec.NewRequest(r)}
+}
+
+type errorCodecRequest struct {
+	rpc.CodecRequest
+}
+
+// WriteError impl rpc.CodecRequest
+func (c *errorCodecRequest) WriteError(w http.ResponseWriter, status int, err error) {
+	c.CodecRequest.WriteError(w, status, apierrors.ToRPCError(err))
+}
@@ -0,0 +1,224 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/reflection"
+
+	"github.com/anyswap/CrossChain-Bridge/internal/swapapi"
+	"github.com/anyswap/CrossChain-Bridge/log"
+	"github.com/anyswap/CrossChain-Bridge/params"
+	"github.com/anyswap/CrossChain-Bridge/rpc/apierrors"
+	"github.com/anyswap/CrossChain-Bridge/rpc/grpcapi"
+)
+
+// StartGRPCServer start grpc server if 'GRPCPort' is configed
+func StartGRPCServer() {
+	grpcPort := params.GetConfig().APIServer.GRPCPort
+	if grpcPort == 0 {
+		return
+	}
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%v", grpcPort))
+	if err != nil {
+		log.Fatal("grpc server listen failed", "port", grpcPort, "err", err)
+	}
+
+	startSubscribeHub()
+
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(unaryInterceptor),
+		grpc.StreamInterceptor(streamInterceptor),
+	)
+	grpcapi.RegisterSwapServiceServer(grpcServer, &grpcSwapService{})
+	// server reflection lets standard tools (eg. grpcurl) call without the proto file
+	reflection.Register(grpcServer)
+
+	log.Info("gRPC service listen and serving", "port", grpcPort)
+	go func() {
+		if err := grpcServer.Serve(listener); err != nil {
+			log.Error("grpc Serve error", "err", err)
+		}
+	}()
+}
+
+func getGRPCClientIP(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+			return host
+		}
+		return p.Addr.String()
+	}
+	return ""
+}
+
+func getGRPCAPIKey(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(grpcapi.APIKeyMetadataKey); len(values) != 0 {
+			return values[0]
+		}
+	}
+	return ""
+}
+
+func checkGRPCRequest(ctx context.Context, method string) error {
+	_, err := getAPIGuard().check(getGRPCAPIKey(ctx), getGRPCClientIP(ctx), method)
+	return err
+}
+
+func unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := checkGRPCRequest(ctx, info.FullMethod); err != nil {
+		return nil, grpcapi.ToStatusError(ctx, nil, err)
+	}
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return nil, grpcapi.ToStatusError(ctx, nil, err)
+	}
+	return resp, nil
+}
+
+func streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := checkGRPCRequest(ss.Context(), info.FullMethod); err != nil {
+		return grpcapi.ToStatusError(ss.Context(), ss, err)
+	}
+	return grpcapi.ToStatusError(ss.Context(), ss, handler(srv, ss))
+}
+
+// grpcSwapService impl grpcapi.SwapServiceServer
+type grpcSwapService struct {
+	grpcapi.UnimplementedSwapServiceServer
+}
+
+func (s *grpcSwapService) GetServerInfo(ctx context.Context, req *grpcapi.Empty) (*grpcapi.ServerInfo, error) {
+	info, err := swapapi.GetServerInfo()
+	if err != nil {
+		return nil, err
+	}
+	return grpcapi.NewServerInfo(info), nil
+}
+
+func (s *grpcSwapService) GetTokenPairInfo(ctx context.Context, req *grpcapi.PairIDRequest) (*grpcapi.TokenPairInfo, error) {
+	pairCfg, err := swapapi.GetTokenPairInfo(req.Pairid)
+	if err != nil {
+		return nil, err
+	}
+	return grpcapi.NewTokenPairInfo(pairCfg), nil
+}
+
+func (s *grpcSwapService) GetSwapStatistics(ctx context.Context, req *grpcapi.PairIDRequest) (*grpcapi.SwapStatistics, error) {
+	stat, err := swapapi.GetSwapStatistics(req.Pairid)
+	if err != nil {
+		return nil, err
+	}
+	return grpcapi.NewSwapStatistics(stat), nil
+}
+
+func checkTxAndPairIDRequest(req *grpcapi.TxAndPairIDRequest) error {
+	if req.Txid == "" {
+		return apierrors.InvalidArgument.New("empty tx id")
+	}
+	if req.Pairid == "" {
+		return apierrors.InvalidArgument.New("empty pair id")
+	}
+	return nil
+}
+
+func (s *grpcSwapService) GetSwapin(ctx context.Context, req *grpcapi.TxAndPairIDRequest) (*grpcapi.SwapInfo, error) {
+	if err := checkTxAndPairIDRequest(req); err != nil {
+		return nil, err
+	}
+	swap, err := swapapi.GetSwapin(&req.Txid, &req.Pairid, &req.Bind)
+	if err != nil {
+		return nil, err
+	}
+	return grpcapi.NewSwapInfo(swap), nil
+}
+
+func (s *grpcSwapService) GetSwapout(ctx context.Context, req *grpcapi.TxAndPairIDRequest) (*grpcapi.SwapInfo, error) {
+	if err := checkTxAndPairIDRequest(req); err != nil {
+		return nil, err
+	}
+	swap, err := swapapi.GetSwapout(&req.Txid, &req.Pairid, &req.Bind)
+	if err != nil {
+		return nil, err
+	}
+	return grpcapi.NewSwapInfo(swap), nil
+}
+
+func (s *grpcSwapService) GetSwapinHistory(ctx context.Context, req *grpcapi.QueryHistoryRequest) (*grpcapi.SwapInfoList, error) {
+	swaps, err := swapapi.GetSwapinHistory(req.Address, req.Pairid, int(req.Offset), int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+	return grpcapi.NewSwapInfoList(swaps), nil
+}
+
+func (s *grpcSwapService) GetSwapoutHistory(ctx context.Context, req *grpcapi.QueryHistoryRequest) (*grpcapi.SwapInfoList, error) {
+	swaps, err := swapapi.GetSwapoutHistory(req.Address, req.Pairid, int(req.Offset), int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+	return grpcapi.NewSwapInfoList(swaps), nil
+}
+
+func (s *grpcSwapService) Swapin(ctx context.Context, req *grpcapi.TxAndPairIDRequest) (*grpcapi.PostReply, error) {
+	if err := checkTxAndPairIDRequest(req); err != nil {
+		return nil, err
+	}
+	result, err := swapapi.Swapin(&req.Txid, &req.Pairid)
+	if err != nil {
+		return nil, err
+	}
+	return &grpcapi.PostReply{Result: string(*result)}, nil
+}
+
+func (s *grpcSwapService) Swapout(ctx context.Context, req *grpcapi.TxAndPairIDRequest) (*grpcapi.PostReply, error) {
+	if err := checkTxAndPairIDRequest(req); err != nil {
+		return nil, err
+	}
+	result, err := swapapi.Swapout(&req.Txid, &req.Pairid)
+	if err != nil {
+		return nil, err
+	}
+	return &grpcapi.PostReply{Result: string(*result)}, nil
+}
+
+func (s *grpcSwapService) GetLatestScanInfo(ctx context.Context, req *grpcapi.LatestScanInfoRequest) (*grpcapi.LatestScanInfo, error) {
+	info, err := swapapi.GetLatestScanInfo(req.Issrc)
+	if err != nil {
+		return nil, err
+	}
+	return grpcapi.NewLatestScanInfo(info), nil
+}
+
+func (s *grpcSwapService) WatchSwaps(req *grpcapi.WatchSwapsRequest, stream grpcapi.SwapService_WatchSwapsServer) error {
+	filter := &swapFilter{
+		txids:   toLowerSet(req.Txids),
+		binds:   toLowerSet(req.Binds),
+		pairIDs: toLowerSet(req.Pairids),
+	}
+	if len(filter.txids) == 0 && len(filter.binds) == 0 && len(filter.pairIDs) == 0 {
+		return apierrors.InvalidArgument.New("must specify at least one of 'txids', 'binds' and 'pairids'")
+	}
+	client, err := addSubscriber(filter)
+	if err != nil {
+		return err
+	}
+	defer removeSubscriber(client)
+
+	ctx := stream.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg := <-client.ch:
+			if err := stream.Send(grpcapi.NewSwapEvent(msg)); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,288 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/anyswap/CrossChain-Bridge/log"
+	"github.com/anyswap/CrossChain-Bridge/params"
+	"github.com/anyswap/CrossChain-Bridge/rpc/apierrors"
+	"github.com/anyswap/CrossChain-Bridge/rpc/grpcapi"
+	"github.com/anyswap/CrossChain-Bridge/rpc/restapi"
+)
+
+const (
+	apiKeyHeader = "X-API-Key"
+
+	maxRPCBodySize      = 1 << 20
+	bucketCleanInterval = time.Minute
+	bucketIdleTime      = 10 * time.Minute
+)
+
+var (
+	// methods which write to database
+	writeMethods = map[string]struct{}{
+		"swap.Swapin":                   {},
+		"swap.Swapout":                  {},
+		"swap.P2shSwapin":               {},
+		"swap.RetrySwapin":              {},
+		"swap.RegisterP2shAddress":      {},
+		"swap.RegisterAddress":          {},
+		"/swapin/post/{pairid}/{txid}":  {},
+		"/swapout/post/{pairid}/{txid}": {},
+		"/swapin/p2sh/{txid}/{bind}":    {},
+		"/swapin/retry/{pairid}/{txid}": {},
+		"/p2sh/bind/{address}":          {},
+		"/register/{address}":           {},
+
+		grpcapi.FullMethodName("Swapin"):  {},
+		grpcapi.FullMethodName("Swapout"): {},
+	}
+
+	// default rate limits of methods if not configured in 'MethodRateLimits',
+	// the public methods are always rate limited per client as they are expensive.
+	defaultMethodRateLimits = map[string]*params.RateLimitConfig{
+		// swap register methods which may be queued in maintenance window
+		"swap.Swapin":                   defaultSwapRegisterRateLimit,
+		"swap.Swapout":                  defaultSwapRegisterRateLimit,
+		"/swapin/post/{pairid}/{txid}":  defaultSwapRegisterRateLimit,
+		"/swapout/post/{pairid}/{txid}": defaultSwapRegisterRateLimit,
+
+		grpcapi.FullMethodName("Swapin"):  defaultSwapRegisterRateLimit,
+		grpcapi.FullMethodName("Swapout"): defaultSwapRegisterRateLimit,
+
+		// nonce methods which query chain nonces of swap signers
+		"swap.GetSwapNonces": defaultSwapNoncesRateLimit,
+		"/nonces/{pairid}":   defaultSwapNoncesRateLimit,
+	}
+
+	defaultSwapRegisterRateLimit = &params.RateLimitConfig{Rate: 0.2, Burst: 5}
+	defaultSwapNoncesRateLimit   = &params.RateLimitConfig{Rate: 0.1, Burst: 2}
+
+	// paths which are not guarded (used by probes and monitors)
+	unguardedPaths = map[string]struct{}{
+		"/health/live":  {},
+		"/health/ready": {},
+		"/metrics":      {},
+	}
+)
+
+type tokenBucket struct {
+	tokens   float64
+	lastTime time.Time
+}
+
+type rateLimiter struct {
+	lock    sync.Mutex
+	buckets map[string]*tokenBucket
+	limits  map[string]*params.RateLimitConfig // limit of each bucket, for cleaning
+}
+
+func newRateLimiter() *rateLimiter {
+	limiter := &rateLimiter{
+		buckets: make(map[string]*tokenBucket),
+		limits:  make(map[string]*params.RateLimitConfig),
+	}
+	go limiter.cleanLoop()
+	return limiter
+}
+
+// allow take a token from the bucket of key, returns the waiting time if not allowed
+func (l *rateLimiter) allow(key string, limit *params.RateLimitConfig) (bool, time.Duration) {
+	if limit == nil || limit.Rate <= 0 {
+		return true, 0
+	}
+	now := time.Now()
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	bucket, exist := l.buckets[key]
+	if !exist {
+		bucket = &tokenBucket{tokens: float64(limit.Burst), lastTime: now}
+		l.buckets[key] = bucket
+		l.limits[key] = limit
+	} else {
+		elapsed := now.Sub(bucket.lastTime).Seconds()
+		bucket.tokens = math.Min(float64(limit.Burst), bucket.tokens+elapsed*limit.Rate)
+		bucket.lastTime = now
+	}
+	if bucket.tokens >= 1 {
+		bucket.tokens--
+		return true, 0
+	}
+	wait := time.Duration((1 - bucket.tokens) / limit.Rate * float64(time.Second))
+	return false, wait
+}
+
+// remove buckets which are idle and refilled, to bound memory usage
+func (l *rateLimiter) cleanLoop() {
+	for {
+		time.Sleep(bucketCleanInterval)
+		now := time.Now()
+		l.lock.Lock()
+		for key, bucket := range l.buckets {
+			limit := l.limits[key]
+			idle := now.Sub(bucket.lastTime)
+			if idle > bucketIdleTime && bucket.tokens+idle.Seconds()*limit.Rate >= float64(limit.Burst) {
+				delete(l.buckets, key)
+				delete(l.limits, key)
+			}
+		}
+		l.lock.Unlock()
+	}
+}
+
+var (
+	guard     *apiGuard
+	guardOnce sync.Once
+)
+
+// apiGuard authenticates api keys and limits request rate per client and per method
+type apiGuard struct {
+	config  *params.APIServerConfig
+	apiKeys map[string]*params.APIKeyConfig
+	limiter *rateLimiter
+}
+
+// getAPIGuard get the api guard shared by http and grpc servers
+func getAPIGuard() *apiGuard {
+	guardOnce.Do(func() {
+		guard = newAPIGuard(params.GetConfig().APIServer)
+	})
+	return guard
+}
+
+// newAPIGuard api guard is always enabled, as some public methods are rate limited by default
+func newAPIGuard(config *params.APIServerConfig) *apiGuard {
+	guard := &apiGuard{
+		config:  config,
+		apiKeys: make(map[string]*params.APIKeyConfig, len(config.APIKeys)),
+		limiter: newRateLimiter(),
+	}
+	for _, apiKey := range config.APIKeys {
+		guard.apiKeys[apiKey.Key] = apiKey
+	}
+	return guard
+}
+
+// Middleware mux middleware
+func (g *apiGuard) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, exist := unguardedPaths[r.URL.Path]; exist {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		method, err := getRequestMethod(w, r)
+		if err != nil {
+			restapi.WriteErrorResponse(w, apierrors.BadRequest.New(err.Error()))
+			return
+		}
+
+		if wait, err := g.check(r.Header.Get(apiKeyHeader), g.getClientIP(r), method); err != nil {
+			if wait > 0 {
+				w.Header().Set("Retry-After", fmt.Sprintf("%d", int64(math.Ceil(wait.Seconds()))))
+			}
+			restapi.WriteErrorResponse(w, err)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// check authenticates api key and limits request rate of client calling method,
+// returns the suggested waiting time if rate limited.
+func (g *apiGuard) check(key, clientIP, method string) (time.Duration, error) {
+	var apiKey *params.APIKeyConfig
+	if key != "" {
+		var exist bool
+		if apiKey, exist = g.apiKeys[key]; !exist {
+			return 0, apierrors.Unauthorized.New("invalid api key")
+		}
+	}
+
+	if apiKey == nil && g.config.RequireAPIKeyForWrite {
+		if _, isWrite := writeMethods[method]; isWrite {
+			return 0, apierrors.Unauthorized.New("api key is required")
+		}
+	}
+
+	var clientID string
+	var clientLimit *params.RateLimitConfig
+	if apiKey != nil {
+		clientID = "key:" + apiKey.Key
+		clientLimit = apiKey.RateLimit
+		if clientLimit == nil {
+			clientLimit = g.config.APIKeyRateLimit
+		}
+	} else {
+		clientID = "ip:" + clientIP
+		clientLimit = g.config.IPRateLimit
+	}
+
+	if ok, wait := g.limiter.allow(clientID, clientLimit); !ok {
+		log.Debug("api request rate limited", "client", clientID, "method", method)
+		return wait, apierrors.RateLimited.New("rate limit exceeded")
+	}
+	methodLimit, exist := g.config.MethodRateLimits[method]
+	if !exist {
+		methodLimit, exist = defaultMethodRateLimits[method]
+	}
+	if exist {
+		if ok, wait := g.limiter.allow(clientID+"/"+method, methodLimit); !ok {
+			log.Debug("api method quota exceeded", "client", clientID, "method", method)
+			return wait, apierrors.RateLimited.New("rate limit exceeded")
+		}
+	}
+	return 0, nil
+}
+
+func (g *apiGuard) getClientIP(r *http.Request) string {
+	if g.config.TrustProxyHeaders {
+		if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+			return strings.TrimSpace(strings.Split(forwarded, ",")[0])
+		}
+		if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
+			return strings.TrimSpace(realIP)
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// getRequestMethod get json rpc method name, or path template of rest api
+func getRequestMethod(w http.ResponseWriter, r *http.Request) (string, error) {
+	if r.URL.Path != "/rpc" {
+		if route := mux.CurrentRoute(r); route != nil {
+			if tpl, err := route.GetPathTemplate(); err == nil {
+				return tpl, nil
+			}
+		}
+		return r.URL.Path, nil
+	}
+	if r.Body == nil {
+		return "", nil
+	}
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxRPCBodySize))
+	if err != nil {
+		return "", fmt.Errorf("read request body failed, %v", err)
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+	var req struct {
+		Method string `json:"method"`
+	}
+	_ = json.Unmarshal(body, &req)
+	return req.Method, nil
+}
@@ -0,0 +1,55 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/anyswap/CrossChain-Bridge/params"
+)
+
+func TestRateLimiterAllow(t *testing.T) {
+	limit := &params.RateLimitConfig{Rate: 2, Burst: 3}
+	tests := []struct {
+		key     string
+		limit   *params.RateLimitConfig
+		elapsed time.Duration // time passed since the last call of key
+		allowed bool
+	}{
+		{"unlimited", nil, 0, true},
+		{"unlimited", &params.RateLimitConfig{Burst: 1}, 0, true},
+		// burst is allowed at once
+		{"client1", limit, 0, true},
+		{"client1", limit, 0, true},
+		{"client1", limit, 0, true},
+		{"client1", limit, 0, false},
+		// buckets of keys are independent
+		{"client2", limit, 0, true},
+		// refilled 1 token in 500ms
+		{"client1", limit, 500 * time.Millisecond, true},
+		{"client1", limit, 0, false},
+		// refilled at most burst tokens
+		{"client1", limit, time.Hour, true},
+		{"client1", limit, 0, true},
+		{"client1", limit, 0, true},
+		{"client1", limit, 0, false},
+	}
+	limiter := &rateLimiter{
+		buckets: make(map[string]*tokenBucket),
+		limits:  make(map[string]*params.RateLimitConfig),
+	}
+	for i, test := range tests {
+		if bucket, exist := limiter.buckets[test.key]; exist {
+			bucket.lastTime = bucket.lastTime.Add(-test.elapsed)
+		}
+		allowed, wait := limiter.allow(test.key, test.limit)
+		if allowed != test.allowed {
+			t.Errorf("test %d: allow(%v) = %v, want %v", i, test.key, allowed, test.allowed)
+		}
+		if allowed && wait != 0 {
+			t.Errorf("test %d: allowed with wait %v", i, wait)
+		}
+		if !allowed && (wait <= 0 || wait > time.Second/time.Duration(test.limit.Rate)) {
+			t.Errorf("test %d: wrong wait %v of rate %v", i, wait, test.limit.Rate)
+		}
+	}
+}
@@ -8,10 +8,11 @@ import (
 	"github.com/gorilla/handlers"
 	"github.com/gorilla/mux"
 	"github.com/gorilla/rpc/v2"
-	rpcjson "github.com/gorilla/rpc/v2/json2"
 
+	"github.com/anyswap/CrossChain-Bridge/internal/metrics"
 	"github.com/anyswap/CrossChain-Bridge/log"
 	"github.com/anyswap/CrossChain-Bridge/params"
+	"github.com/anyswap/CrossChain-Bridge/rpc/apierrors"
 	"github.com/anyswap/CrossChain-Bridge/rpc/restapi"
 	"github.com/anyswap/CrossChain-Bridge/rpc/rpcapi"
 )
@@ -29,7 +30,7 @@ func StartAPIServer() {
 	}
 	if len(allowedOrigins) != 0 {
 		corsOptions = append(corsOptions,
-			handlers.AllowedHeaders([]string{"X-Requested-With", "Content-Type"}),
+			handlers.AllowedHeaders([]string{"X-Requested-With", "Content-Type", apiKeyHeader}),
 			handlers.AllowedOrigins(allowedOrigins),
 		)
 	}
@@ -51,13 +52,19 @@ func StartAPIServer() {
 func initRouter() *mux.Router {
 	r := mux.NewRouter()
 
+	r.Use(getAPIGuard().Middleware)
+
+	startSubscribeHub()
+
 	rpcserver := rpc.NewServer()
-	rpcserver.RegisterCodec(rpcjson.NewCodec(), "application/json")
+	rpcserver.RegisterCodec(newErrorCodec(), "application/json")
 	_ = rpcserver.RegisterService(new(rpcapi.RPCAPI), "swap")
 
 	r.Handle("/rpc", rpcserver)
 	r.HandleFunc("/serverinfo", restapi.ServerInfoHandler).Methods("GET")
 	r.HandleFunc("/versioninfo", restapi.VersionInfoHandler).Methods("GET")
+	r.HandleFunc("/health/live", restapi.HealthLiveHandler).Methods("GET")
+	r.HandleFunc("/health/ready", restapi.HealthReadyHandler).Methods("GET")
 	r.HandleFunc("/pairinfo/{pairid}", restapi.TokenPairInfoHandler).Methods("GET")
 	r.HandleFunc("/statistics/{pairid}", restapi.StatisticsHandler).Methods("GET")
 	r.HandleFunc("/swapin/post/{pairid}/{txid}", restapi.PostSwapinHandler).Methods("POST")
@@ -72,10 +79,20 @@ func initRouter() *mux.Router {
 	r.HandleFunc("/swapout/{pairid}/{txid}/rawresult", restapi.GetRawSwapoutResultHandler).Methods("GET")
 	r.HandleFunc("/swapin/history/{pairid}/{address}", restapi.SwapinHistoryHandler).Methods("GET")
 	r.HandleFunc("/swapout/history/{pairid}/{address}", restapi.SwapoutHistoryHandler).Methods("GET")
+	r.HandleFunc("/swaps", restapi.QuerySwapsHandler).Methods("GET")
+	r.HandleFunc("/quote/{pairid}/{direction}/{amount}", restapi.QuoteSwapHandler).Methods("GET")
+	r.HandleFunc("/overview/{address}", restapi.AddressOverviewHandler).Methods("GET")
+	r.HandleFunc("/nonces/{pairid}", restapi.SwapNoncesHandler).Methods("GET")
 	r.HandleFunc("/p2sh/{address}", restapi.GetP2shAddressInfo).Methods("GET", "POST")
 	r.HandleFunc("/p2sh/bind/{address}", restapi.RegisterP2shAddress).Methods("GET", "POST")
 	r.HandleFunc("/registered/{address}", restapi.GetRegisteredAddress).Methods("GET", "POST")
 	r.HandleFunc("/register/{address}", restapi.RegisterAddress).Methods("GET", "POST")
+	r.HandleFunc("/subscribe/ws", SubscribeWebSocketHandler).Methods("GET")
+	r.HandleFunc("/subscribe/sse", SubscribeSSEHandler).Methods("GET")
+	r.Handle("/metrics", metrics.Handler()).Methods("GET")
+	r.HandleFunc("/openapi.json", restapi.OpenAPIHandler).Methods("GET")
+	r.HandleFunc("/openrpc.json", restapi.OpenRPCHandler).Methods("GET")
+	r.HandleFunc("/errors", restapi.ErrorCatalogueHandler).Methods("GET")
 
 	methodsExcluesGet := []string{"POST", "HEAD", "PUT", "DELETE", "CONNECT", "OPTIONS", "TRACE", "PATCH"}
 	methodsExcluesPost := []string{"GET", "HEAD", "PUT", "DELETE", "CONNECT", "OPTIONS", "TRACE", "PATCH"}
@@ -83,6 +100,8 @@ func initRouter() *mux.Router {
 
 	r.HandleFunc("/serverinfo", warnHandler).Methods(methodsExcluesGet...)
 	r.HandleFunc("/versioninfo", warnHandler).Methods(methodsExcluesGet...)
+	r.HandleFunc("/health/live", warnHandler).Methods(methodsExcluesGet...)
+	r.HandleFunc("/health/ready", warnHandler).Methods(methodsExcluesGet...)
 	r.HandleFunc("/pairinfo/{pairid}", warnHandler).Methods(methodsExcluesGet...)
 	r.HandleFunc("/statistics/{pairid}", warnHandler).Methods(methodsExcluesGet...)
 	r.HandleFunc("/swapin/post/{pairid}/{txid}", warnHandler).Methods(methodsExcluesPost...)
@@ -97,14 +116,24 @@ func initRouter() *mux.Router {
 	r.HandleFunc("/swapout/{pairid}/{txid}/rawresult", warnHandler).Methods(methodsExcluesGet...)
 	r.HandleFunc("/swapin/history/{pairid}/{address}", warnHandler).Methods(methodsExcluesGet...)
 	r.HandleFunc("/swapout/history/{pairid}/{address}", warnHandler).Methods(methodsExcluesGet...)
+	r.HandleFunc("/swaps", warnHandler).Methods(methodsExcluesGet...)
+	r.HandleFunc("/quote/{pairid}/{direction}/{amount}", warnHandler).Methods(methodsExcluesGet...)
+	r.HandleFunc("/overview/{address}", warnHandler).Methods(methodsExcluesGet...)
+	r.HandleFunc("/nonces/{pairid}", warnHandler).Methods(methodsExcluesGet...)
 	r.HandleFunc("/p2sh/{address}", warnHandler).Methods(methodsExcluesGetAndPost...)
 	r.HandleFunc("/p2sh/bind/{address}", warnHandler).Methods(methodsExcluesGetAndPost...)
 	r.HandleFunc("/registered/{address}", warnHandler).Methods(methodsExcluesGetAndPost...)
 	r.HandleFunc("/register/{address}", warnHandler).Methods(methodsExcluesGetAndPost...)
+	r.HandleFunc("/subscribe/ws", warnHandler).Methods(methodsExcluesGet...)
+	r.HandleFunc("/subscribe/sse", warnHandler).Methods(methodsExcluesGet...)
+	r.HandleFunc("/metrics", warnHandler).Methods(methodsExcluesGet...)
+	r.HandleFunc("/openapi.json", warnHandler).Methods(methodsExcluesGet...)
+	r.HandleFunc("/openrpc.json", warnHandler).Methods(methodsExcluesGet...)
+	r.HandleFunc("/errors", warnHandler).Methods(methodsExcluesGet...)
 
 	return r
 }
 
 func warnHandler(w http.ResponseWriter, r *http.Request) {
-	fmt.Fprintf(w, "Forbid '%v' on '%v'\n", r.Method, r.RequestURI)
+	restapi.WriteErrorResponse(w, apierrors.MethodNotAllowed.Errorf("Forbid '%v' on '%v'", r.Method, r.RequestURI))
 }
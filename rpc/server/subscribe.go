@@ -0,0 +1,320 @@
+package server
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/anyswap/CrossChain-Bridge/internal/swapapi"
+	"github.com/anyswap/CrossChain-Bridge/internal/swapevent"
+	"github.com/anyswap/CrossChain-Bridge/log"
+	"github.com/anyswap/CrossChain-Bridge/params"
+	"github.com/anyswap/CrossChain-Bridge/rpc/apierrors"
+	"github.com/anyswap/CrossChain-Bridge/rpc/apitypes"
+	"github.com/anyswap/CrossChain-Bridge/rpc/restapi"
+)
+
+const (
+	maxSubscribers       = 1000
+	subscriberBufferSize = 64
+	heartbeatInterval    = 30 * time.Second
+	wsPongWait           = 2 * heartbeatInterval
+	wsWriteWait          = 10 * time.Second
+)
+
+var (
+	hubStarter sync.Once
+	hubLock    sync.RWMutex
+	hubClients = make(map[*subscriber]struct{})
+
+	wsUpgrader = websocket.Upgrader{
+		ReadBufferSize:  1024,
+		WriteBufferSize: 1024,
+		CheckOrigin:     checkOrigin,
+	}
+)
+
+// SwapEventMessage type alias
+type SwapEventMessage = apitypes.SwapEventMessage
+
+// swapFilter matches swap if all of the specified fields match,
+// and a field matches if any of its values match.
+type swapFilter struct {
+	txids   map[string]struct{}
+	binds   map[string]struct{}
+	pairIDs map[string]struct{}
+}
+
+type subscriber struct {
+	filter *swapFilter
+	ch     chan *SwapEventMessage
+}
+
+func toLowerSet(values []string) map[string]struct{} {
+	if len(values) == 0 {
+		return nil
+	}
+	set := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				set[strings.ToLower(item)] = struct{}{}
+			}
+		}
+	}
+	return set
+}
+
+func parseSwapFilter(r *http.Request) (*swapFilter, error) {
+	vals := r.URL.Query()
+	filter := &swapFilter{
+		txids:   toLowerSet(vals["txid"]),
+		binds:   toLowerSet(vals["bind"]),
+		pairIDs: toLowerSet(vals["pairid"]),
+	}
+	if len(filter.txids) == 0 && len(filter.binds) == 0 && len(filter.pairIDs) == 0 {
+		return nil, apierrors.InvalidArgument.New("must specify at least one of 'txid', 'bind' and 'pairid'")
+	}
+	return filter, nil
+}
+
+func matchSet(set map[string]struct{}, value string) bool {
+	if len(set) == 0 {
+		return true
+	}
+	_, exist := set[strings.ToLower(value)]
+	return exist
+}
+
+func (f *swapFilter) match(ev *swapevent.Event) bool {
+	return matchSet(f.txids, ev.TxID) &&
+		matchSet(f.binds, ev.Bind) &&
+		matchSet(f.pairIDs, ev.PairID)
+}
+
+func startSubscribeHub() {
+	hubStarter.Do(func() {
+		sub := swapevent.Subscribe(0)
+		go func() {
+			for ev := range sub.C {
+				dispatchSwapEvent(ev)
+			}
+		}()
+	})
+}
+
+func dispatchSwapEvent(ev *swapevent.Event) {
+	var matched []*subscriber
+	hubLock.RLock()
+	for client := range hubClients {
+		if client.filter.match(ev) {
+			matched = append(matched, client)
+		}
+	}
+	hubLock.RUnlock()
+	if len(matched) == 0 {
+		return
+	}
+
+	// load swap once for all matched subscribers
+	msg := loadSwapEventMessage(ev)
+	if msg == nil {
+		return
+	}
+	for _, client := range matched {
+		select {
+		case client.ch <- msg:
+		default:
+			log.Debug("drop swap event to slow subscriber", "txid", ev.TxID, "pairID", ev.PairID, "bind", ev.Bind)
+		}
+	}
+}
+
+func loadSwapEventMessage(ev *swapevent.Event) *SwapEventMessage {
+	var (
+		swapInfo *swapapi.SwapInfo
+		err      error
+	)
+	if ev.IsSwapin {
+		swapInfo, err = swapapi.GetSwapin(&ev.TxID, &ev.PairID, &ev.Bind)
+	} else {
+		swapInfo, err = swapapi.GetSwapout(&ev.TxID, &ev.PairID, &ev.Bind)
+	}
+	if err != nil {
+		log.Debug("load swap of event failed", "txid", ev.TxID, "pairID", ev.PairID, "bind", ev.Bind, "isSwapin", ev.IsSwapin, "err", err)
+		return nil
+	}
+	return &SwapEventMessage{IsSwapin: ev.IsSwapin, Swap: swapInfo}
+}
+
+func addSubscriber(filter *swapFilter) (*subscriber, error) {
+	hubLock.Lock()
+	defer hubLock.Unlock()
+	if len(hubClients) >= maxSubscribers {
+		return nil, apierrors.ServiceUnavailable.New("too many subscribers")
+	}
+	client := &subscriber{
+		filter: filter,
+		ch:     make(chan *SwapEventMessage, subscriberBufferSize),
+	}
+	hubClients[client] = struct{}{}
+	return client, nil
+}
+
+func removeSubscriber(client *subscriber) {
+	hubLock.Lock()
+	defer hubLock.Unlock()
+	delete(hubClients, client)
+}
+
+func checkOrigin(r *http.Request) bool {
+	allowedOrigins := params.GetConfig().APIServer.AllowedOrigins
+	if len(allowedOrigins) == 0 {
+		return true
+	}
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	for _, allowed := range allowedOrigins {
+		if allowed == "*" || strings.EqualFold(allowed, origin) {
+			return true
+		}
+	}
+	return false
+}
+
+// SubscribeWebSocketHandler push swap status changes over websocket
+func SubscribeWebSocketHandler(w http.ResponseWriter, r *http.Request) {
+	filter, err := parseSwapFilter(r)
+	if err != nil {
+		restapi.WriteErrorResponse(w, err)
+		return
+	}
+	client, err := addSubscriber(filter)
+	if err != nil {
+		restapi.WriteErrorResponse(w, err)
+		return
+	}
+	defer removeSubscriber(client)
+
+	conn, err := wsUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Debug("websocket upgrade failed", "err", err)
+		return
+	}
+	defer conn.Close()
+
+	// read and discard client messages, detect closing by read error
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		_ = conn.SetReadDeadline(time.Now().Add(wsPongWait))
+		conn.SetPongHandler(func(string) error {
+			return conn.SetReadDeadline(time.Now().Add(wsPongWait))
+		})
+		for {
+			if _, _, errr := conn.ReadMessage(); errr != nil {
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(heartbeatInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-closed:
+			return
+		case msg := <-client.ch:
+			_ = conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+			if err = conn.WriteJSON(msg); err != nil {
+				return
+			}
+		case <-ticker.C:
+			_ = conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+			if err = conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// SubscribeSSEHandler push swap status changes as server-sent events
+func SubscribeSSEHandler(w http.ResponseWriter, r *http.Request) {
+	filter, err := parseSwapFilter(r)
+	if err != nil {
+		restapi.WriteErrorResponse(w, err)
+		return
+	}
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		restapi.WriteErrorResponse(w, apierrors.InternalError.New("streaming is not supported"))
+		return
+	}
+	client, err := addSubscriber(filter)
+	if err != nil {
+		restapi.WriteErrorResponse(w, err)
+		return
+	}
+	defer removeSubscriber(client)
+
+	// hijack the connection as the stream lives longer than the server write timeout
+	conn, bufrw, err := hijacker.Hijack()
+	if err != nil {
+		log.Debug("sse hijack failed", "err", err)
+		return
+	}
+	defer conn.Close()
+	_ = conn.SetDeadline(time.Time{})
+
+	header := w.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("Connection", "close")
+	_, _ = bufrw.WriteString("HTTP/1.1 200 OK\r\n")
+	_ = header.Write(bufrw)
+	_, _ = bufrw.WriteString("\r\n")
+	if err = bufrw.Flush(); err != nil {
+		return
+	}
+
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		_, _ = bufrw.Reader.WriteTo(ioutil.Discard)
+	}()
+
+	ticker := time.NewTicker(heartbeatInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-closed:
+			return
+		case msg := <-client.ch:
+			data, _ := json.Marshal(msg)
+			err = writeSSE(conn, bufrw.Writer, fmt.Sprintf("event: swap\ndata: %s\n\n", data))
+		case <-ticker.C:
+			err = writeSSE(conn, bufrw.Writer, ": ping\n\n")
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+func writeSSE(conn net.Conn, w *bufio.Writer, content string) error {
+	_ = conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+	if _, err := w.WriteString(content); err != nil {
+		return err
+	}
+	return w.Flush()
+}
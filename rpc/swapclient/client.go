@@ -0,0 +1,308 @@
+// Package swapclient is a typed client of swap server api
+package swapclient
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/anyswap/CrossChain-Bridge/rpc/apierrors"
+	"github.com/anyswap/CrossChain-Bridge/rpc/apitypes"
+	"github.com/anyswap/CrossChain-Bridge/rpc/client"
+)
+
+const (
+	defaultTimeout   = 60 // seconds
+	defaultRequestID = 1
+
+	apiKeyHeader = "X-API-Key"
+)
+
+// Client swap server api client
+type Client struct {
+	serverURL string // json rpc url, eg. http://127.0.0.1:11556/rpc
+	timeout   int    // seconds
+	apiKey    string
+}
+
+// NewClient new client of swap server json rpc url
+func NewClient(serverURL string) *Client {
+	return &Client{
+		serverURL: serverURL,
+		timeout:   defaultTimeout,
+	}
+}
+
+// SetTimeout set request timeout in seconds
+func (c *Client) SetTimeout(timeout int) {
+	c.timeout = timeout
+}
+
+// SetAPIKey set api key sent in 'X-API-Key' header
+func (c *Client) SetAPIKey(apiKey string) {
+	c.apiKey = apiKey
+}
+
+// GetServerURL get swap server json rpc url
+func (c *Client) GetServerURL() string {
+	return c.serverURL
+}
+
+func (c *Client) getHeaders() map[string]string {
+	if c.apiKey == "" {
+		return nil
+	}
+	return map[string]string{apiKeyHeader: c.apiKey}
+}
+
+func (c *Client) call(result interface{}, method string, params ...interface{}) error {
+	return c.callWithTimeoutAndID(result, c.timeout, defaultRequestID, method, params...)
+}
+
+func (c *Client) callWithTimeoutAndID(result interface{}, timeout, id int, method string, params ...interface{}) error {
+	req := client.NewRequestWithTimeoutAndID(timeout, id, "swap."+method, params...)
+	req.Headers = c.getHeaders()
+	err := client.RPCPostRequest(c.serverURL, req, result)
+	var jsonErr *client.JSONError
+	if errors.As(err, &jsonErr) {
+		return apierrors.FromRPCError(jsonErr.Code, jsonErr.Message, jsonErr.Data)
+	}
+	return err
+}
+
+// GetVersionInfo get version info
+func (c *Client) GetVersionInfo() (string, error) {
+	var result string
+	err := c.call(&result, "GetVersionInfo")
+	return result, err
+}
+
+// GetErrorCatalogue get error catalogue
+func (c *Client) GetErrorCatalogue() ([]*apierrors.ErrorInfo, error) {
+	var result []*apierrors.ErrorInfo
+	err := c.call(&result, "GetErrorCatalogue")
+	return result, err
+}
+
+// GetServerInfo get server info
+func (c *Client) GetServerInfo() (*apitypes.ServerInfo, error) {
+	var result apitypes.ServerInfo
+	err := c.call(&result, "GetServerInfo")
+	return &result, err
+}
+
+// GetTokenPairInfo get token pair info
+func (c *Client) GetTokenPairInfo(pairID string) (*apitypes.TokenPairInfo, error) {
+	var result apitypes.TokenPairInfo
+	err := c.call(&result, "GetTokenPairInfo", pairID)
+	return &result, err
+}
+
+// GetSwapStatistics get swap statistics
+func (c *Client) GetSwapStatistics(pairID string) (*apitypes.SwapStatistics, error) {
+	var result apitypes.SwapStatistics
+	err := c.call(&result, "GetSwapStatistics", pairID)
+	return &result, err
+}
+
+func newTxAndPairIDArgs(txid, pairID, bind string) *apitypes.TxAndPairIDArgs {
+	return &apitypes.TxAndPairIDArgs{TxID: txid, PairID: pairID, Bind: bind}
+}
+
+// GetRawSwapin get raw swapin
+func (c *Client) GetRawSwapin(txid, pairID, bind string) (*apitypes.Swap, error) {
+	var result apitypes.Swap
+	err := c.call(&result, "GetRawSwapin", newTxAndPairIDArgs(txid, pairID, bind))
+	return &result, err
+}
+
+// GetRawSwapinResult get raw swapin result
+func (c *Client) GetRawSwapinResult(txid, pairID, bind string) (*apitypes.SwapResult, error) {
+	var result apitypes.SwapResult
+	err := c.call(&result, "GetRawSwapinResult", newTxAndPairIDArgs(txid, pairID, bind))
+	return &result, err
+}
+
+// GetSwapin get swapin
+func (c *Client) GetSwapin(txid, pairID, bind string) (*apitypes.SwapInfo, error) {
+	var result apitypes.SwapInfo
+	err := c.call(&result, "GetSwapin", newTxAndPairIDArgs(txid, pairID, bind))
+	return &result, err
+}
+
+// GetRawSwapout get raw swapout
+func (c *Client) GetRawSwapout(txid, pairID, bind string) (*apitypes.Swap, error) {
+	var result apitypes.Swap
+	err := c.call(&result, "GetRawSwapout", newTxAndPairIDArgs(txid, pairID, bind))
+	return &result, err
+}
+
+// GetRawSwapoutResult get raw swapout result
+func (c *Client) GetRawSwapoutResult(txid, pairID, bind string) (*apitypes.SwapResult, error) {
+	var result apitypes.SwapResult
+	err := c.call(&result, "GetRawSwapoutResult", newTxAndPairIDArgs(txid, pairID, bind))
+	return &result, err
+}
+
+// GetSwapout get swapout
+func (c *Client) GetSwapout(txid, pairID, bind string) (*apitypes.SwapInfo, error) {
+	var result apitypes.SwapInfo
+	err := c.call(&result, "GetSwapout", newTxAndPairIDArgs(txid, pairID, bind))
+	return &result, err
+}
+
+// QuerySwaps query swaps with filters and cursor pagination
+func (c *Client) QuerySwaps(args *apitypes.QuerySwapsArgs) (*apitypes.QuerySwapsResult, error) {
+	var result apitypes.QuerySwapsResult
+	err := c.call(&result, "QuerySwaps", args)
+	return &result, err
+}
+
+// QuoteSwap estimate received value and fee of swapping amount (in smallest unit)
+func (c *Client) QuoteSwap(pairID, direction, amount string) (*apitypes.QuoteSwapResult, error) {
+	var result apitypes.QuoteSwapResult
+	args := &apitypes.QuoteSwapArgs{PairID: pairID, Direction: direction, Amount: amount}
+	err := c.call(&result, "QuoteSwap", args)
+	return &result, err
+}
+
+// GetAddressOverview get overview of swaps and states of address across all token pairs
+func (c *Client) GetAddressOverview(address string) (*apitypes.AddressOverview, error) {
+	var result apitypes.AddressOverview
+	err := c.call(&result, "GetAddressOverview", address)
+	return &result, err
+}
+
+// GetSwapNonces get swap nonces of signers in database and on chain, pairID can be 'all'
+func (c *Client) GetSwapNonces(pairID string) ([]*apitypes.SwapNonceInfo, error) {
+	var result []*apitypes.SwapNonceInfo
+	err := c.call(&result, "GetSwapNonces", pairID)
+	return result, err
+}
+
+// GetSwapinHistory get swapin history
+func (c *Client) GetSwapinHistory(address, pairID string, offset, limit int) ([]*apitypes.SwapInfo, error) {
+	var result []*apitypes.SwapInfo
+	args := &apitypes.QueryHistoryArgs{Address: address, PairID: pairID, Offset: offset, Limit: limit}
+	err := c.call(&result, "GetSwapinHistory", args)
+	return result, err
+}
+
+// GetSwapoutHistory get swapout history
+func (c *Client) GetSwapoutHistory(address, pairID string, offset, limit int) ([]*apitypes.SwapInfo, error) {
+	var result []*apitypes.SwapInfo
+	args := &apitypes.QueryHistoryArgs{Address: address, PairID: pairID, Offset: offset, Limit: limit}
+	err := c.call(&result, "GetSwapoutHistory", args)
+	return result, err
+}
+
+// Swapin post swapin
+func (c *Client) Swapin(txid, pairID string) (apitypes.PostResult, error) {
+	var result apitypes.PostResult
+	err := c.call(&result, "Swapin", newTxAndPairIDArgs(txid, pairID, ""))
+	return result, err
+}
+
+// RetrySwapin retry swapin
+func (c *Client) RetrySwapin(txid, pairID string) (apitypes.PostResult, error) {
+	var result apitypes.PostResult
+	err := c.call(&result, "RetrySwapin", newTxAndPairIDArgs(txid, pairID, ""))
+	return result, err
+}
+
+// P2shSwapin post p2sh swapin
+func (c *Client) P2shSwapin(txid, bind string) (apitypes.PostResult, error) {
+	var result apitypes.PostResult
+	err := c.call(&result, "P2shSwapin", &apitypes.P2shSwapinArgs{TxID: txid, Bind: bind})
+	return result, err
+}
+
+// Swapout post swapout
+func (c *Client) Swapout(txid, pairID string) (apitypes.PostResult, error) {
+	var result apitypes.PostResult
+	err := c.call(&result, "Swapout", newTxAndPairIDArgs(txid, pairID, ""))
+	return result, err
+}
+
+// IsValidSwapinBindAddress is valid swapin bind address
+func (c *Client) IsValidSwapinBindAddress(address string) (bool, error) {
+	var result bool
+	err := c.call(&result, "IsValidSwapinBindAddress", address)
+	return result, err
+}
+
+// IsValidSwapoutBindAddress is valid swapout bind address
+func (c *Client) IsValidSwapoutBindAddress(address string) (bool, error) {
+	var result bool
+	err := c.call(&result, "IsValidSwapoutBindAddress", address)
+	return result, err
+}
+
+// RegisterP2shAddress register p2sh address of bind address
+func (c *Client) RegisterP2shAddress(bindAddress string) (*apitypes.P2shAddressInfo, error) {
+	var result apitypes.P2shAddressInfo
+	err := c.call(&result, "RegisterP2shAddress", bindAddress)
+	return &result, err
+}
+
+// GetP2shAddressInfo get p2sh address info
+func (c *Client) GetP2shAddressInfo(p2shAddress string) (*apitypes.P2shAddressInfo, error) {
+	var result apitypes.P2shAddressInfo
+	err := c.call(&result, "GetP2shAddressInfo", p2shAddress)
+	return &result, err
+}
+
+// GetLatestScanInfo get latest scan info
+func (c *Client) GetLatestScanInfo(isSrc bool) (*apitypes.LatestScanInfo, error) {
+	var result apitypes.LatestScanInfo
+	err := c.call(&result, "GetLatestScanInfo", isSrc)
+	return &result, err
+}
+
+// RegisterAddress register address
+func (c *Client) RegisterAddress(address string) (apitypes.PostResult, error) {
+	var result apitypes.PostResult
+	err := c.call(&result, "RegisterAddress", address)
+	return result, err
+}
+
+// GetRegisteredAddress get registered address
+func (c *Client) GetRegisteredAddress(address string) (*apitypes.RegisteredAddress, error) {
+	var result apitypes.RegisteredAddress
+	err := c.call(&result, "GetRegisteredAddress", address)
+	return &result, err
+}
+
+// AdminCall call admin method with signed raw tx
+func (c *Client) AdminCall(rawTx string, timeout, reqID int) (string, error) {
+	var result string
+	err := c.callWithTimeoutAndID(&result, timeout, reqID, "AdminCall", rawTx)
+	return result, err
+}
+
+// CheckHealth get liveness (ready is false) or readiness (ready is true) health result.
+// unhealthy result is returned without error.
+func (c *Client) CheckHealth(ready bool) (*apitypes.HealthResult, error) {
+	url := strings.TrimSuffix(c.serverURL, "/rpc") + "/health/live"
+	if ready {
+		url = strings.TrimSuffix(c.serverURL, "/rpc") + "/health/ready"
+	}
+	resp, err := client.HTTPGet(url, nil, c.getHeaders(), c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	const maxReadContentLength int64 = 1024 * 1024 // 1M
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxReadContentLength))
+	if err != nil {
+		return nil, fmt.Errorf("read body error: %w", err)
+	}
+	var result apitypes.HealthResult
+	if err = json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("wrong response status %v. message: %v", resp.StatusCode, string(body))
+	}
+	return &result, nil
+}
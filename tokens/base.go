@@ -3,6 +3,10 @@ package tokens
 import (
 	"math"
 	"math/big"
+	"sync/atomic"
+	"time"
+
+	"github.com/anyswap/CrossChain-Bridge/internal/metrics"
 )
 
 // transaction memo prefix
@@ -10,11 +14,14 @@ const (
 	LockMemoPrefix   = "SWAPTO:"
 	UnlockMemoPrefix = "SWAPTX:"
 	AggregateMemo    = "aggregate"
+	SweepMemo        = "sweep"
 )
 
 // common variables
 var (
 	AggregateIdentifier = "aggregate"
+	SweepIdentifier     = "sweep"
+	PayoutIdentifier    = "payout"
 
 	SrcBridge CrossChainBridge
 	DstBridge CrossChainBridge
@@ -23,6 +30,10 @@ var (
 	DstLatestBlockHeight uint64
 
 	IsDcrmDisabled bool
+
+	// unix time when latest block height advanced
+	srcLatestBlockUpdateTime int64
+	dstLatestBlockUpdateTime int64
 )
 
 // CrossChainBridgeBase base bridge
@@ -148,10 +159,17 @@ func CalcSwappedValue(pairID string, value *big.Int, isSrc bool) *big.Int {
 // SetLatestBlockHeight set latest block height
 func SetLatestBlockHeight(latest uint64, isSrc bool) {
 	if isSrc {
+		if latest != SrcLatestBlockHeight {
+			atomic.StoreInt64(&srcLatestBlockUpdateTime, time.Now().Unix())
+		}
 		SrcLatestBlockHeight = latest
 	} else {
+		if latest != DstLatestBlockHeight {
+			atomic.StoreInt64(&dstLatestBlockUpdateTime, time.Now().Unix())
+		}
 		DstLatestBlockHeight = latest
 	}
+	metrics.SetLatestBlockHeight(isSrc, latest)
 }
 
 // CmpAndSetLatestBlockHeight cmp and set latest block height
@@ -159,10 +177,22 @@ func CmpAndSetLatestBlockHeight(latest uint64, isSrc bool) {
 	if isSrc {
 		if latest > SrcLatestBlockHeight {
 			SrcLatestBlockHeight = latest
+			atomic.StoreInt64(&srcLatestBlockUpdateTime, time.Now().Unix())
+			metrics.SetLatestBlockHeight(isSrc, latest)
 		}
 	} else {
 		if latest > DstLatestBlockHeight {
 			DstLatestBlockHeight = latest
+			atomic.StoreInt64(&dstLatestBlockUpdateTime, time.Now().Unix())
+			metrics.SetLatestBlockHeight(isSrc, latest)
 		}
 	}
 }
+
+// GetLatestBlockUpdateTime get unix time when latest block height advanced last time
+func GetLatestBlockUpdateTime(isSrc bool) int64 {
+	if isSrc {
+		return atomic.LoadInt64(&srcLatestBlockUpdateTime)
+	}
+	return atomic.LoadInt64(&dstLatestBlockUpdateTime)
+}
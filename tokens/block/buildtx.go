@@ -111,7 +111,7 @@ func (b *Bridge) BuildRawTransaction(args *tokens.BuildTxArgs) (rawTx interface{
 
 	updateExtraInfo(extra, authoredTx.Tx.TxIn)
 
-	if args.SwapType != tokens.NoSwapType {
+	if args.SwapType != tokens.NoSwapType && args.Identifier != tokens.PayoutIdentifier {
 		args.Identifier = params.GetIdentifier()
 	}
 
@@ -52,6 +52,7 @@ func InitCrossChainBridge(isServer bool) {
 	dstNet := dstChain.NetID
 
 	tokens.AggregateIdentifier = fmt.Sprintf("%s:%s", params.GetIdentifier(), tokens.AggregateIdentifier)
+	tokens.PayoutIdentifier = fmt.Sprintf("%s:%s", params.GetIdentifier(), tokens.PayoutIdentifier)
 
 	tokens.SrcBridge = NewCrossChainBridge(srcID, true)
 	tokens.DstBridge = NewCrossChainBridge(dstID, false)
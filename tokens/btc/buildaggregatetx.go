@@ -13,11 +13,20 @@ import (
 
 // BuildAggregateTransaction build aggregate tx (spend p2sh utxo)
 func (b *Bridge) BuildAggregateTransaction(relayFeePerKb int64, addrs []string, utxos []*electrs.ElectUtxo) (rawTx *txauthor.AuthoredTx, err error) {
+	return b.buildSpendAllTransaction(relayFeePerKb, cfgUtxoAggregateToAddress, tokens.AggregateMemo, addrs, utxos)
+}
+
+// BuildSweepTransaction build sweep tx (spend all utxos to address)
+func (b *Bridge) BuildSweepTransaction(relayFeePerKb int64, toAddress string, addrs []string, utxos []*electrs.ElectUtxo) (rawTx *txauthor.AuthoredTx, err error) {
+	return b.buildSpendAllTransaction(relayFeePerKb, toAddress, tokens.SweepMemo, addrs, utxos)
+}
+
+func (b *Bridge) buildSpendAllTransaction(relayFeePerKb int64, toAddress, memo string, addrs []string, utxos []*electrs.ElectUtxo) (rawTx *txauthor.AuthoredTx, err error) {
 	if len(addrs) != len(utxos) {
-		return nil, fmt.Errorf("call BuildAggregateTransaction: count of addrs (%v) is not equal to count of utxos (%v)", len(addrs), len(utxos))
+		return nil, fmt.Errorf("call buildSpendAllTransaction: count of addrs (%v) is not equal to count of utxos (%v)", len(addrs), len(utxos))
 	}
 
-	txOuts, err := b.getTxOutputs("", nil, tokens.AggregateMemo)
+	txOuts, err := b.getTxOutputs("", nil, memo)
 	if err != nil {
 		return nil, err
 	}
@@ -27,7 +36,7 @@ func (b *Bridge) BuildAggregateTransaction(relayFeePerKb int64, addrs []string,
 	}
 
 	changeSource := func() ([]byte, error) {
-		return b.GetPayToAddrScript(cfgUtxoAggregateToAddress)
+		return b.GetPayToAddrScript(toAddress)
 	}
 
 	return b.NewUnsignedTransaction(txOuts, btcAmountType(relayFeePerKb), inputSource, changeSource, true)
@@ -13,14 +13,20 @@ import (
 	"github.com/anyswap/CrossChain-Bridge/dcrm"
 	"github.com/anyswap/CrossChain-Bridge/log"
 	"github.com/anyswap/CrossChain-Bridge/tokens"
-	"github.com/anyswap/CrossChain-Bridge/tools/crypto"
 	"github.com/btcsuite/btcwallet/wallet/txauthor"
 )
 
 func (b *Bridge) verifyTransactionWithArgs(tx *txauthor.AuthoredTx, args *tokens.BuildTxArgs) error {
 	checkReceiver := args.Bind
-	if args.Identifier == tokens.AggregateIdentifier {
+	switch args.Identifier {
+	case tokens.AggregateIdentifier:
 		checkReceiver = cfgUtxoAggregateToAddress
+	case tokens.SweepIdentifier:
+		tokenCfg := b.GetTokenConfig(args.PairID)
+		if tokenCfg == nil {
+			return tokens.ErrUnknownPairID
+		}
+		checkReceiver = tokenCfg.DcrmAddress
 	}
 	payToReceiverScript, err := b.GetPayToAddrScript(checkReceiver)
 	if err != nil {
@@ -51,7 +57,7 @@ func (b *Bridge) DcrmSignTransaction(rawTx interface{}, args *tokens.BuildTxArgs
 		return nil, "", err
 	}
 
-	cPkData, err := b.GetCompressedPublicKey(cfgFromPublicKey, false)
+	cPkData, err := b.GetCompressedPublicKey(b.getSignPublicKey(args), false)
 	if err != nil {
 		return nil, "", err
 	}
@@ -205,17 +211,6 @@ func (b *Bridge) GetCompressedPublicKey(fromPublicKey string, needVerify bool) (
 	return cPkData, nil
 }
 
-// the rsv must have correct v (recovery id), otherwise will get wrong public key data.
-func (b *Bridge) getPkDataFromSig(rsv, msgHash string, compressed bool) (pkData []byte, err error) {
-	rsvData := common.FromHex(rsv)
-	hashData := common.FromHex(msgHash)
-	ecPub, err := crypto.SigToPub(hashData, rsvData)
-	if err != nil {
-		return nil, err
-	}
-	return b.SerializePublicKey(ecPub, compressed), nil
-}
-
 // DcrmSignMsgHash dcrm sign msg hash
 func (b *Bridge) DcrmSignMsgHash(msgHash []string, args *tokens.BuildTxArgs) (rsv []string, err error) {
 	extra := args.Extra.BtcExtra
@@ -225,8 +220,9 @@ func (b *Bridge) DcrmSignMsgHash(msgHash []string, args *tokens.BuildTxArgs) (rs
 	jsondata, _ := json.Marshal(args)
 	msgContext := []string{string(jsondata)}
 
+	signPubkey := b.getSignPublicKey(args)
 	log.Info(b.ChainConfig.BlockChain+" DcrmSignTransaction start", "msgContext", msgContext, "txid", args.SwapID)
-	keyID, rsv, err := dcrm.DoSign(cfgFromPublicKey, msgHash, msgContext)
+	keyID, rsv, err := dcrm.DoSign(signPubkey, msgHash, msgContext)
 	if err != nil {
 		return nil, err
 	}
@@ -236,41 +232,17 @@ func (b *Bridge) DcrmSignMsgHash(msgHash []string, args *tokens.BuildTxArgs) (rs
 		return nil, fmt.Errorf("get sign status require %v rsv but have %v (keyID = %v)", len(msgHash), len(rsv), keyID)
 	}
 
-	rsv, err = b.adjustRsvOrders(rsv, msgHash, cfgFromPublicKey)
-	if err != nil {
-		return nil, err
-	}
-
 	log.Trace(b.ChainConfig.BlockChain+" DcrmSignTransaction get rsv success", "keyID", keyID, "txid", args.SwapID, "rsv", rsv)
 	return rsv, nil
 }
 
-func (b *Bridge) adjustRsvOrders(rsvs, msgHashes []string, fromPublicKey string) (newRsvs []string, err error) {
-	if len(rsvs) <= 1 {
-		return rsvs, nil
-	}
-	fromPubkeyData, err := b.GetCompressedPublicKey(fromPublicKey, false)
-	matchedRsvMap := make(map[string]struct{})
-	var cPkData []byte
-	for _, msgHash := range msgHashes {
-		matched := false
-		for _, rsv := range rsvs {
-			if _, exist := matchedRsvMap[rsv]; exist {
-				continue
-			}
-			cPkData, err = b.getPkDataFromSig(rsv, msgHash, true)
-			if err == nil && bytes.Equal(cPkData, fromPubkeyData) {
-				matchedRsvMap[rsv] = struct{}{}
-				newRsvs = append(newRsvs, rsv)
-				matched = true
-				break
-			}
-		}
-		if !matched {
-			return nil, fmt.Errorf("msgHash %v hash no matched rsv", msgHash)
-		}
+// sign with the retiring dcrm public key if sign from the retiring dcrm address
+func (b *Bridge) getSignPublicKey(args *tokens.BuildTxArgs) string {
+	tokenCfg := b.GetTokenConfig(args.PairID)
+	if tokenCfg != nil && tokenCfg.IsRetiringDcrmAddress(args.From) {
+		return tokenCfg.RetiringDcrmPubkey
 	}
-	return newRsvs, err
+	return cfgFromPublicKey
 }
 
 // SignTransaction sign tx with pairID
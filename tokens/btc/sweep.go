@@ -0,0 +1,117 @@
+package btc
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/anyswap/CrossChain-Bridge/log"
+	"github.com/anyswap/CrossChain-Bridge/tokens"
+	"github.com/anyswap/CrossChain-Bridge/tokens/btc/electrs"
+)
+
+// SweepRetiringDcrmAddress sweep all utxos of retiring dcrm address to active dcrm address,
+// the caller should check there is no unstable swap tx sent from the retiring dcrm address
+func (b *Bridge) SweepRetiringDcrmAddress(pairID string) (string, error) {
+	tokenCfg := b.GetTokenConfig(pairID)
+	if tokenCfg == nil {
+		return "", tokens.ErrUnknownPairID
+	}
+	if !tokenCfg.IsMigrating() {
+		return "", tokens.ErrNotMigrating
+	}
+	from := tokenCfg.RetiringDcrmAddress
+
+	findUtxos, err := b.FindUtxos(from)
+	if err != nil {
+		return "", err
+	}
+	var (
+		addrs []string
+		utxos []*electrs.ElectUtxo
+	)
+	for _, utxo := range findUtxos {
+		if utxo.Value == nil || *utxo.Value == 0 {
+			continue
+		}
+		addrs = append(addrs, from)
+		utxos = append(utxos, utxo)
+	}
+	if len(utxos) == 0 {
+		return "", tokens.ErrNothingToSweep
+	}
+
+	relayFee, err := b.getRelayFeePerKb()
+	if err != nil {
+		return "", err
+	}
+
+	authoredTx, err := b.BuildSweepTransaction(relayFee, tokenCfg.DcrmAddress, addrs, utxos)
+	if err != nil {
+		return "", err
+	}
+
+	args := &tokens.BuildTxArgs{
+		SwapInfo: tokens.SwapInfo{
+			PairID:     pairID,
+			Identifier: tokens.SweepIdentifier,
+		},
+		From: from,
+		Extra: &tokens.AllExtras{
+			BtcExtra: &tokens.BtcExtraArgs{},
+		},
+	}
+
+	extra := args.Extra.BtcExtra
+	extra.RelayFeePerKb = &relayFee
+	extra.PreviousOutPoints = make([]*tokens.BtcOutPoint, len(authoredTx.Tx.TxIn))
+	for i, txin := range authoredTx.Tx.TxIn {
+		point := txin.PreviousOutPoint
+		extra.PreviousOutPoints[i] = &tokens.BtcOutPoint{
+			Hash:  point.Hash.String(),
+			Index: point.Index,
+		}
+	}
+
+	signedTx, txHash, err := b.DcrmSignTransaction(authoredTx, args.GetExtraArgs())
+	if err != nil {
+		return "", err
+	}
+	_, err = b.SendTransaction(signedTx)
+	if err != nil {
+		return "", err
+	}
+	log.Info(b.ChainConfig.BlockChain+" sweep retiring dcrm address success", "pairID", pairID, "from", from, "to", tokenCfg.DcrmAddress, "utxos", len(utxos), "txHash", txHash)
+	return txHash, nil
+}
+
+// VerifySweepMsgHash verify sweep msgHash
+func (b *Bridge) VerifySweepMsgHash(msgHash []string, args *tokens.BuildTxArgs) error {
+	tokenCfg := b.GetTokenConfig(args.PairID)
+	if tokenCfg == nil {
+		return tokens.ErrUnknownPairID
+	}
+	if !tokenCfg.IsRetiringDcrmAddress(args.From) {
+		return fmt.Errorf("sweep from non retiring dcrm address %v", args.From)
+	}
+	if args.Extra == nil || args.Extra.BtcExtra == nil || len(args.Extra.BtcExtra.PreviousOutPoints) == 0 {
+		return errors.New("empty btc extra")
+	}
+	extra := args.Extra.BtcExtra
+	if extra.RelayFeePerKb == nil {
+		return errors.New("empty relay fee")
+	}
+	addrs, utxos, err := b.getUtxosFromOutPoints(extra.PreviousOutPoints)
+	if err != nil {
+		return err
+	}
+	for _, addr := range addrs {
+		if addr != tokenCfg.RetiringDcrmAddress {
+			return fmt.Errorf("sweep utxo of non retiring dcrm address %v", addr)
+		}
+	}
+	rawTx, err := b.BuildSweepTransaction(*extra.RelayFeePerKb, tokenCfg.DcrmAddress, addrs, utxos)
+	if err != nil {
+		return err
+	}
+	return b.VerifyMsgHash(rawTx, msgHash)
+}
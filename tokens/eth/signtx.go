@@ -31,6 +31,9 @@ func (b *Bridge) verifyTransactionWithArgs(rawTx interface{}, args *tokens.Build
 	if args.SwapType == tokens.SwapoutType && !tokenCfg.IsErc20() {
 		checkReceiver = args.Bind
 	}
+	if args.Identifier == tokens.SweepIdentifier && !tokenCfg.IsErc20() {
+		checkReceiver = tokenCfg.DcrmAddress
+	}
 	if !strings.EqualFold(tx.To().String(), checkReceiver) {
 		return nil, fmt.Errorf("[sign] verify tx receiver failed")
 	}
@@ -53,7 +56,8 @@ func (b *Bridge) DcrmSignTransaction(rawTx interface{}, args *tokens.BuildTxArgs
 	msgContext := string(jsondata)
 
 	log.Info(b.ChainConfig.BlockChain+" DcrmSignTransaction start", "msghash", msgHash.String(), "txid", args.SwapID)
-	keyID, rsvs, err := dcrm.DoSignOne(b.GetDcrmPublicKey(args.PairID), msgHash.String(), msgContext)
+	signPubkey := b.GetTokenConfig(args.PairID).GetDcrmPubkeyOf(args.From)
+	keyID, rsvs, err := dcrm.DoSignOne(signPubkey, msgHash.String(), msgContext)
 	if err != nil {
 		return nil, "", err
 	}
@@ -63,7 +67,56 @@ func (b *Bridge) DcrmSignTransaction(rawTx interface{}, args *tokens.BuildTxArgs
 		return nil, "", fmt.Errorf("get sign status require one rsv but have %v (keyID = %v)", len(rsvs), keyID)
 	}
 
-	rsv := rsvs[0]
+	return b.makeSignedTransaction(tx, rsvs[0], args, keyID)
+}
+
+// DcrmSignTransactions dcrm sign raw txs in one sign round
+func (b *Bridge) DcrmSignTransactions(rawTxs []interface{}, argsList []*tokens.BuildTxArgs) (signedTxs []interface{}, txHashes []string, err error) {
+	if len(rawTxs) == 0 || len(rawTxs) != len(argsList) {
+		return nil, nil, fmt.Errorf("mismatch number of raw txs and args")
+	}
+	signPubkey := b.GetTokenConfig(argsList[0].PairID).GetDcrmPubkeyOf(argsList[0].From)
+	txs := make([]*types.Transaction, len(rawTxs))
+	msgHashes := make([]string, len(rawTxs))
+	msgContexts := make([]string, len(rawTxs))
+	signer := b.Signer
+	for i, rawTx := range rawTxs {
+		args := argsList[i]
+		txs[i], err = b.verifyTransactionWithArgs(rawTx, args)
+		if err != nil {
+			return nil, nil, err
+		}
+		if b.GetTokenConfig(args.PairID).GetDcrmPubkeyOf(args.From) != signPubkey {
+			return nil, nil, fmt.Errorf("batch sign with different dcrm public keys")
+		}
+		msgHashes[i] = signer.Hash(txs[i]).String()
+		jsondata, _ := json.Marshal(args)
+		msgContexts[i] = string(jsondata)
+	}
+
+	log.Info(b.ChainConfig.BlockChain+" DcrmSignTransactions start", "msghashes", msgHashes)
+	keyID, rsvs, err := dcrm.DoSign(signPubkey, msgHashes, msgContexts)
+	if err != nil {
+		return nil, nil, err
+	}
+	log.Info(b.ChainConfig.BlockChain+" DcrmSignTransactions finished", "keyID", keyID, "msghashes", msgHashes)
+
+	if len(rsvs) != len(txs) {
+		return nil, nil, fmt.Errorf("get sign status require %v rsvs but have %v (keyID = %v)", len(txs), len(rsvs), keyID)
+	}
+
+	signedTxs = make([]interface{}, len(txs))
+	txHashes = make([]string, len(txs))
+	for i, tx := range txs {
+		signedTxs[i], txHashes[i], err = b.makeSignedTransaction(tx, rsvs[i], argsList[i], keyID)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return signedTxs, txHashes, nil
+}
+
+func (b *Bridge) makeSignedTransaction(tx *types.Transaction, rsv string, args *tokens.BuildTxArgs, keyID string) (signTx interface{}, txHash string, err error) {
 	log.Trace(b.ChainConfig.BlockChain+" DcrmSignTransaction get rsv success", "keyID", keyID, "txid", args.SwapID, "rsv", rsv)
 	signature := common.FromHex(rsv)
 	if len(signature) != crypto.SignatureLength {
@@ -71,6 +124,7 @@ func (b *Bridge) DcrmSignTransaction(rawTx interface{}, args *tokens.BuildTxArgs
 		return nil, "", errors.New("wrong signature of keyID " + keyID)
 	}
 
+	signer := b.Signer
 	signedTx, err := tx.WithSignature(signer, signature)
 	if err != nil {
 		return nil, "", err
@@ -83,8 +137,9 @@ func (b *Bridge) DcrmSignTransaction(rawTx interface{}, args *tokens.BuildTxArgs
 
 	pairID := args.PairID
 	token := b.GetTokenConfig(pairID)
-	if sender != common.HexToAddress(token.DcrmAddress) {
-		log.Error("DcrmSignTransaction verify sender failed", "have", sender.String(), "want", token.DcrmAddress)
+	dcrmAddress := token.GetDcrmAddressOf(args.From)
+	if sender != common.HexToAddress(dcrmAddress) {
+		log.Error("DcrmSignTransaction verify sender failed", "have", sender.String(), "want", dcrmAddress)
 		return nil, "", errors.New("wrong sender address")
 	}
 	txHash = signedTx.Hash().String()
@@ -0,0 +1,154 @@
+package eth
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/anyswap/CrossChain-Bridge/common"
+	"github.com/anyswap/CrossChain-Bridge/log"
+	"github.com/anyswap/CrossChain-Bridge/tokens"
+	"github.com/anyswap/CrossChain-Bridge/types"
+)
+
+const (
+	sweepCoinGasLimit = 21000
+)
+
+// SweepRetiringDcrmAddress sweep remaining funds from retiring dcrm address to active dcrm address
+func (b *Bridge) SweepRetiringDcrmAddress(pairID string) (txHash string, err error) {
+	tokenCfg := b.GetTokenConfig(pairID)
+	if tokenCfg == nil {
+		return "", tokens.ErrUnknownPairID
+	}
+	if !tokenCfg.IsMigrating() {
+		return "", tokens.ErrNotMigrating
+	}
+	from := tokenCfg.RetiringDcrmAddress
+
+	// wait pending swaps to finish on the retiring dcrm address
+	pendingNonce, err := b.GetPoolNonce(from, "pending")
+	if err != nil {
+		return "", err
+	}
+	latestNonce, err := b.GetPoolNonce(from, "latest")
+	if err != nil {
+		return "", err
+	}
+	if pendingNonce > latestNonce {
+		return "", tokens.ErrRetiringHasPendingTxs
+	}
+
+	gasPrice, err := b.getGasPrice()
+	if err != nil {
+		return "", err
+	}
+
+	args := &tokens.BuildTxArgs{
+		SwapInfo: tokens.SwapInfo{
+			PairID:     pairID,
+			Identifier: tokens.SweepIdentifier,
+		},
+		From: from,
+		Extra: &tokens.AllExtras{
+			EthExtra: &tokens.EthExtraArgs{
+				GasPrice: gasPrice,
+				Nonce:    &latestNonce,
+			},
+		},
+	}
+	extra := args.Extra.EthExtra
+
+	if tokenCfg.IsErc20() {
+		balance, errf := b.GetErc20Balance(tokenCfg.ContractAddress, from)
+		if errf != nil {
+			return "", errf
+		}
+		if balance.Sign() <= 0 {
+			return "", tokens.ErrNothingToSweep
+		}
+		input := PackDataWithFuncHash(erc20CodeParts["transfer"], common.HexToAddress(tokenCfg.DcrmAddress), balance)
+		args.To = tokenCfg.ContractAddress
+		args.Input = &input
+	} else {
+		balance, errf := b.GetBalance(from)
+		if errf != nil {
+			return "", errf
+		}
+		gasLimit := uint64(sweepCoinGasLimit)
+		extra.Gas = &gasLimit
+		gasFee := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(gasLimit))
+		value := new(big.Int).Sub(balance, gasFee)
+		if value.Sign() <= 0 {
+			return "", tokens.ErrNothingToSweep
+		}
+		input := []byte{}
+		args.To = tokenCfg.DcrmAddress
+		args.Value = value
+		args.Input = &input
+	}
+
+	rawTx, err := b.BuildRawTransaction(args)
+	if err != nil {
+		return "", err
+	}
+	// oracles rebuild sweep tx from full args
+	signedTx, txHash, err := b.DcrmSignTransaction(rawTx, args)
+	if err != nil {
+		return "", err
+	}
+	_, err = b.SendTransaction(signedTx)
+	if err != nil {
+		return "", err
+	}
+	log.Info(b.ChainConfig.BlockChain+" sweep retiring dcrm address success", "pairID", pairID, "from", from, "to", tokenCfg.DcrmAddress, "txHash", txHash)
+	return txHash, nil
+}
+
+// VerifySweepMsgHash verify sweep msgHash
+func (b *Bridge) VerifySweepMsgHash(msgHash []string, args *tokens.BuildTxArgs) error {
+	tokenCfg := b.GetTokenConfig(args.PairID)
+	if tokenCfg == nil {
+		return tokens.ErrUnknownPairID
+	}
+	if !tokenCfg.IsRetiringDcrmAddress(args.From) {
+		return fmt.Errorf("sweep from non retiring dcrm address %v", args.From)
+	}
+	if args.Extra == nil || args.Extra.EthExtra == nil {
+		return errors.New("empty eth extra")
+	}
+	extra := args.Extra.EthExtra
+	if extra.Nonce == nil || extra.Gas == nil || extra.GasPrice == nil {
+		return errors.New("sweep without nonce, gas or gas price")
+	}
+	var input []byte
+	if args.Input != nil {
+		input = *args.Input
+	}
+	value := args.Value
+	if value == nil {
+		value = big.NewInt(0)
+	}
+	if tokenCfg.IsErc20() {
+		if !strings.EqualFold(args.To, tokenCfg.ContractAddress) {
+			return tokens.ErrTxWithWrongReceiver
+		}
+		if value.Sign() != 0 || len(input) != 68 || !bytes.Equal(input[:4], erc20CodeParts["transfer"]) {
+			return tokens.ErrTxWithWrongInput
+		}
+		if common.BytesToAddress(input[4:36]) != common.HexToAddress(tokenCfg.DcrmAddress) {
+			return tokens.ErrTxWithWrongReceiver
+		}
+	} else {
+		if !strings.EqualFold(args.To, tokenCfg.DcrmAddress) {
+			return tokens.ErrTxWithWrongReceiver
+		}
+		if len(input) != 0 {
+			return tokens.ErrTxWithWrongInput
+		}
+	}
+	rawTx := types.NewTransaction(*extra.Nonce, common.HexToAddress(args.To), value, *extra.Gas, extra.GasPrice, input)
+	return b.VerifyMsgHash(rawTx, msgHash)
+}
@@ -20,6 +20,9 @@ var (
 	ErrBuildSwapTxInWrongEndpoint    = errors.New("build swap in/out tx in wrong endpoint")
 	ErrTxBeforeInitialHeight         = errors.New("transaction before initial block height")
 	ErrAddressIsInBlacklist          = errors.New("address is in black list")
+	ErrNotMigrating                  = errors.New("dcrm address is not in rotation")
+	ErrRetiringHasPendingTxs         = errors.New("retiring dcrm address has pending txs")
+	ErrNothingToSweep                = errors.New("nothing to sweep")
 
 	ErrTodo = errors.New("developing: TODO")
 
@@ -100,6 +103,17 @@ type CrossChainBridge interface {
 	GetTokenSupply(tokenType, tokenAddress string) (*big.Int, error)
 }
 
+// BatchDcrmSigner interface (sign multiple swap txs in one dcrm sign round)
+type BatchDcrmSigner interface {
+	DcrmSignTransactions(rawTxs []interface{}, argsList []*BuildTxArgs) (signedTxs []interface{}, txHashes []string, err error)
+}
+
+// Sweeper interface (sweep remaining funds from retiring dcrm address to active dcrm address)
+type Sweeper interface {
+	SweepRetiringDcrmAddress(pairID string) (txHash string, err error)
+	VerifySweepMsgHash(msgHash []string, args *BuildTxArgs) error
+}
+
 // NonceSetter interface (for eth-like)
 type NonceSetter interface {
 	GetTxBlockInfo(txHash string) (blockHeight, blockTime uint64)
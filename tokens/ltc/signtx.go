@@ -13,7 +13,6 @@ import (
 	"github.com/anyswap/CrossChain-Bridge/dcrm"
 	"github.com/anyswap/CrossChain-Bridge/log"
 	"github.com/anyswap/CrossChain-Bridge/tokens"
-	"github.com/anyswap/CrossChain-Bridge/tools/crypto"
 	"github.com/ltcsuite/ltcwallet/wallet/txauthor"
 )
 
@@ -205,17 +204,6 @@ func (b *Bridge) GetCompressedPublicKey(fromPublicKey string, needVerify bool) (
 	return cPkData, nil
 }
 
-// the rsv must have correct v (recovery id), otherwise will get wrong public key data.
-func (b *Bridge) getPkDataFromSig(rsv, msgHash string, compressed bool) (pkData []byte, err error) {
-	rsvData := common.FromHex(rsv)
-	hashData := common.FromHex(msgHash)
-	ecPub, err := crypto.SigToPub(hashData, rsvData)
-	if err != nil {
-		return nil, err
-	}
-	return b.SerializePublicKey(ecPub, compressed), nil
-}
-
 // DcrmSignMsgHash dcrm sign msg hash
 func (b *Bridge) DcrmSignMsgHash(msgHash []string, args *tokens.BuildTxArgs) (rsv []string, err error) {
 	extra := args.Extra.BtcExtra
@@ -236,43 +224,10 @@ func (b *Bridge) DcrmSignMsgHash(msgHash []string, args *tokens.BuildTxArgs) (rs
 		return nil, fmt.Errorf("get sign status require %v rsv but have %v (keyID = %v)", len(msgHash), len(rsv), keyID)
 	}
 
-	rsv, err = b.adjustRsvOrders(rsv, msgHash, cfgFromPublicKey)
-	if err != nil {
-		return nil, err
-	}
-
 	log.Trace(b.ChainConfig.BlockChain+" DcrmSignTransaction get rsv success", "keyID", keyID, "txid", args.SwapID, "rsv", rsv)
 	return rsv, nil
 }
 
-func (b *Bridge) adjustRsvOrders(rsvs, msgHashes []string, fromPublicKey string) (newRsvs []string, err error) {
-	if len(rsvs) <= 1 {
-		return rsvs, nil
-	}
-	fromPubkeyData, err := b.GetCompressedPublicKey(fromPublicKey, false)
-	matchedRsvMap := make(map[string]struct{})
-	var cPkData []byte
-	for _, msgHash := range msgHashes {
-		matched := false
-		for _, rsv := range rsvs {
-			if _, exist := matchedRsvMap[rsv]; exist {
-				continue
-			}
-			cPkData, err = b.getPkDataFromSig(rsv, msgHash, true)
-			if err == nil && bytes.Equal(cPkData, fromPubkeyData) {
-				matchedRsvMap[rsv] = struct{}{}
-				newRsvs = append(newRsvs, rsv)
-				matched = true
-				break
-			}
-		}
-		if !matched {
-			return nil, fmt.Errorf("msgHash %v hash no matched rsv", msgHash)
-		}
-	}
-	return newRsvs, err
-}
-
 // SignTransaction sign tx with pairID
 func (b *Bridge) SignTransaction(rawTx interface{}, pairID string) (signedTx interface{}, txHash string, err error) {
 	privKey := b.GetTokenConfig(pairID).GetDcrmAddressPrivateKey()
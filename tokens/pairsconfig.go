@@ -6,7 +6,10 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strings"
+	"sync"
+	"sync/atomic"
 
 	"github.com/BurntSushi/toml"
 	"github.com/anyswap/CrossChain-Bridge/common"
@@ -16,7 +19,15 @@ import (
 var (
 	tokenPairsConfigDirectory string
 
-	tokenPairsConfig map[string]*TokenPairConfig
+	// stores map[string]*TokenPairConfig, the map is replaced as a whole
+	// (copy on write) when modified and never modified after stored
+	tokenPairsConfig atomic.Value
+
+	// serialize modifications of token pairs config
+	tokenPairsConfigLock sync.Mutex
+
+	// key is config file path, value is pairID
+	tokenPairConfigFiles = make(map[string]string)
 )
 
 // TokenPairConfig pair config
@@ -52,17 +63,18 @@ func SetTokenPairsConfig(pairsConfig map[string]*TokenPairConfig, check bool) {
 			log.Fatalf("check token pairs config error: %v", err)
 		}
 	}
-	tokenPairsConfig = pairsConfig
+	tokenPairsConfig.Store(pairsConfig)
 }
 
-// GetTokenPairsConfig get token pairs config
+// GetTokenPairsConfig get token pairs config, the returned map must not be modified
 func GetTokenPairsConfig() map[string]*TokenPairConfig {
-	return tokenPairsConfig
+	pairsConfig, _ := tokenPairsConfig.Load().(map[string]*TokenPairConfig)
+	return pairsConfig
 }
 
 // GetTokenPairConfig get token pair config
 func GetTokenPairConfig(pairID string) *TokenPairConfig {
-	pairCfg, exist := tokenPairsConfig[strings.ToLower(pairID)]
+	pairCfg, exist := GetTokenPairsConfig()[strings.ToLower(pairID)]
 	if !exist {
 		log.Warn("GetTokenPairConfig: pairID not exist", "pairID", pairID)
 		return nil
@@ -72,14 +84,15 @@ func GetTokenPairConfig(pairID string) *TokenPairConfig {
 
 // IsTokenPairExist is token pair exist
 func IsTokenPairExist(pairID string) bool {
-	_, exist := tokenPairsConfig[strings.ToLower(pairID)]
+	_, exist := GetTokenPairsConfig()[strings.ToLower(pairID)]
 	return exist
 }
 
 // GetAllPairIDs get all pairIDs
 func GetAllPairIDs() []string {
-	pairIDs := make([]string, 0, len(tokenPairsConfig))
-	for _, pairCfg := range tokenPairsConfig {
+	pairsConfig := GetTokenPairsConfig()
+	pairIDs := make([]string, 0, len(pairsConfig))
+	for _, pairCfg := range pairsConfig {
 		pairIDs = append(pairIDs, strings.ToLower(pairCfg.PairID))
 	}
 	return pairIDs
@@ -87,7 +100,7 @@ func GetAllPairIDs() []string {
 
 // FindTokenConfig find by (tx to) address
 func FindTokenConfig(address string, isSrc bool) (configs []*TokenConfig, pairIDs []string) {
-	for _, pairCfg := range tokenPairsConfig {
+	for _, pairCfg := range GetTokenPairsConfig() {
 		var tokenCfg *TokenConfig
 		if isSrc {
 			tokenCfg = pairCfg.SrcToken
@@ -112,7 +125,7 @@ func FindTokenConfig(address string, isSrc bool) (configs []*TokenConfig, pairID
 
 // GetTokenConfig get token config
 func GetTokenConfig(pairID string, isSrc bool) *TokenConfig {
-	pairCfg, exist := tokenPairsConfig[strings.ToLower(pairID)]
+	pairCfg, exist := GetTokenPairsConfig()[strings.ToLower(pairID)]
 	if !exist {
 		log.Warn("GetTokenConfig: pairID not exist", "pairID", pairID)
 		return nil
@@ -125,7 +138,7 @@ func GetTokenConfig(pairID string, isSrc bool) *TokenConfig {
 
 // GetTokenConfigsByDirection get token configs by direction
 func GetTokenConfigsByDirection(pairID string, isSwapin bool) (fromTokenConfig, toTokenConfig *TokenConfig) {
-	pairCfg, exist := tokenPairsConfig[strings.ToLower(pairID)]
+	pairCfg, exist := GetTokenPairsConfig()[strings.ToLower(pairID)]
 	if !exist {
 		log.Warn("GetTokenConfigs: pairID not exist", "pairID", pairID)
 		return nil, nil
@@ -211,21 +224,32 @@ func (c *TokenPairConfig) CheckConfig() (err error) {
 
 // LoadTokenPairsConfig load token pairs config
 func LoadTokenPairsConfig(check bool) {
-	pairsConfig, err := LoadTokenPairsConfigInDir(tokenPairsConfigDirectory, check)
+	pairsConfig, configFiles, err := loadTokenPairsConfigInDir(tokenPairsConfigDirectory, check)
 	if err != nil {
 		log.Fatal("load token pair config error", "err", err)
 	}
 	SetTokenPairsConfig(pairsConfig, false)
+	tokenPairsConfigLock.Lock()
+	for configFile, pairID := range configFiles {
+		tokenPairConfigFiles[configFile] = pairID
+	}
+	tokenPairsConfigLock.Unlock()
 }
 
 // LoadTokenPairsConfigInDir load token pairs config
 func LoadTokenPairsConfigInDir(dir string, check bool) (map[string]*TokenPairConfig, error) {
+	pairsConfig, _, err := loadTokenPairsConfigInDir(dir, check)
+	return pairsConfig, err
+}
+
+func loadTokenPairsConfigInDir(dir string, check bool) (pairsConfig map[string]*TokenPairConfig, configFiles map[string]string, err error) {
 	fileInfoList, err := ioutil.ReadDir(dir)
 	if err != nil {
 		log.Error("read directory failed", "dir", dir, "err", err)
-		return nil, err
+		return nil, nil, err
 	}
-	pairsConfig := make(map[string]*TokenPairConfig)
+	pairsConfig = make(map[string]*TokenPairConfig)
+	configFiles = make(map[string]string)
 	for _, info := range fileInfoList {
 		if info.IsDir() {
 			continue
@@ -239,18 +263,20 @@ func LoadTokenPairsConfigInDir(dir string, check bool) (map[string]*TokenPairCon
 		filePath := common.AbsolutePath(dir, fileName)
 		pairConfig, err = loadTokenPairConfig(filePath)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		// use all small case to identify
-		pairsConfig[strings.ToLower(pairConfig.PairID)] = pairConfig
+		pairID := strings.ToLower(pairConfig.PairID)
+		pairsConfig[pairID] = pairConfig
+		configFiles[filepath.Clean(filePath)] = pairID
 	}
 	if check {
 		err = checkTokenPairsConfig(pairsConfig)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
-	return pairsConfig, nil
+	return pairsConfig, configFiles, nil
 }
 
 func loadTokenPairConfig(configFile string) (config *TokenPairConfig, err error) {
@@ -279,16 +305,88 @@ func AddPairConfig(configFile string) (pairConfig *TokenPairConfig, err error) {
 	if err != nil {
 		return nil, err
 	}
+	tokenPairsConfigLock.Lock()
+	defer tokenPairsConfigLock.Unlock()
 	err = checkAddTokenPairsConfig(pairConfig)
 	if err != nil {
 		return nil, err
 	}
 	// use all small case to identify
-	tokenPairsConfig[strings.ToLower(pairConfig.PairID)] = pairConfig
+	pairID := strings.ToLower(pairConfig.PairID)
+	replaceTokenPairConfig(pairID, pairConfig)
+	tokenPairConfigFiles[filepath.Clean(configFile)] = pairID
 	log.Info("add pair config success", "pairID", pairConfig.PairID, "configFile", configFile)
 	return pairConfig, nil
 }
 
+// UpdatePairConfig update config of existing pair dynamically,
+// runtime states set by admin (swap state, maintenance windows) are kept
+func UpdatePairConfig(configFile string) (oldConfig, newConfig *TokenPairConfig, err error) {
+	newConfig, err = loadTokenPairConfig(configFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	tokenPairsConfigLock.Lock()
+	defer tokenPairsConfigLock.Unlock()
+	pairID := strings.ToLower(newConfig.PairID)
+	oldConfig, exist := GetTokenPairsConfig()[pairID]
+	if !exist {
+		return nil, nil, fmt.Errorf("pairID '%v' not exist", pairID)
+	}
+	err = checkUpdateTokenPairsConfig(oldConfig, newConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	newConfig.SrcToken.inheritRuntimeState(oldConfig.SrcToken)
+	newConfig.DestToken.inheritRuntimeState(oldConfig.DestToken)
+	replaceTokenPairConfig(pairID, newConfig)
+	tokenPairConfigFiles[filepath.Clean(configFile)] = pairID
+	log.Info("update pair config success", "pairID", newConfig.PairID, "configFile", configFile)
+	return oldConfig, newConfig, nil
+}
+
+// RemovePairConfig remove pair config dynamically
+func RemovePairConfig(pairID string) (pairConfig *TokenPairConfig, err error) {
+	tokenPairsConfigLock.Lock()
+	defer tokenPairsConfigLock.Unlock()
+	pairID = strings.ToLower(pairID)
+	pairConfig, exist := GetTokenPairsConfig()[pairID]
+	if !exist {
+		return nil, fmt.Errorf("pairID '%v' not exist", pairID)
+	}
+	replaceTokenPairConfig(pairID, nil)
+	for configFile, filePairID := range tokenPairConfigFiles {
+		if filePairID == pairID {
+			delete(tokenPairConfigFiles, configFile)
+		}
+	}
+	log.Info("remove pair config success", "pairID", pairConfig.PairID)
+	return pairConfig, nil
+}
+
+// GetPairIDOfConfigFile get pairID loaded from config file
+func GetPairIDOfConfigFile(configFile string) string {
+	tokenPairsConfigLock.Lock()
+	defer tokenPairsConfigLock.Unlock()
+	return tokenPairConfigFiles[filepath.Clean(configFile)]
+}
+
+// replace the whole config map with a modified copy (delete the pair if config is nil),
+// the caller must hold tokenPairsConfigLock
+func replaceTokenPairConfig(pairID string, pairConfig *TokenPairConfig) {
+	oldPairsConfig := GetTokenPairsConfig()
+	pairsConfig := make(map[string]*TokenPairConfig, len(oldPairsConfig)+1)
+	for key, val := range oldPairsConfig {
+		pairsConfig[key] = val
+	}
+	if pairConfig != nil {
+		pairsConfig[pairID] = pairConfig
+	} else {
+		delete(pairsConfig, pairID)
+	}
+	tokenPairsConfig.Store(pairsConfig)
+}
+
 func checkAddTokenPairsConfig(pairConfig *TokenPairConfig) (err error) {
 	err = pairConfig.CheckConfig()
 	if err != nil {
@@ -303,7 +401,7 @@ func checkAddTokenPairsConfig(pairConfig *TokenPairConfig) (err error) {
 		return err
 	}
 	pairID := strings.ToLower(pairConfig.PairID)
-	if _, exist := tokenPairsConfig[pairID]; exist {
+	if _, exist := GetTokenPairsConfig()[pairID]; exist {
 		return fmt.Errorf("pairID '%v' already exist", pairID)
 	}
 	srcContract := strings.ToLower(pairConfig.SrcToken.ContractAddress)
@@ -311,7 +409,7 @@ func checkAddTokenPairsConfig(pairConfig *TokenPairConfig) (err error) {
 		return fmt.Errorf("source contract address is empty")
 	}
 	dstContract := strings.ToLower(pairConfig.DestToken.ContractAddress)
-	for _, tokenPair := range tokenPairsConfig {
+	for _, tokenPair := range GetTokenPairsConfig() {
 		if strings.EqualFold(srcContract, tokenPair.SrcToken.ContractAddress) {
 			return fmt.Errorf("source contract '%v' already exist", srcContract)
 		}
@@ -321,3 +419,45 @@ func checkAddTokenPairsConfig(pairConfig *TokenPairConfig) (err error) {
 	}
 	return nil
 }
+
+func checkUpdateTokenPairsConfig(oldConfig, newConfig *TokenPairConfig) (err error) {
+	err = newConfig.CheckConfig()
+	if err != nil {
+		return err
+	}
+	err = SrcBridge.VerifyTokenConfig(newConfig.SrcToken)
+	if err != nil {
+		return err
+	}
+	err = DstBridge.VerifyTokenConfig(newConfig.DestToken)
+	if err != nil {
+		return err
+	}
+	if *newConfig.SrcToken.Decimals != *newConfig.DestToken.Decimals {
+		return fmt.Errorf("decimals of pair are not equal, src %v, dest %v", *newConfig.SrcToken.Decimals, *newConfig.DestToken.Decimals)
+	}
+	// swaps in progress depend on the token identity, remove and add pair instead to change them
+	err = checkTokenIdentityUnchanged(oldConfig.SrcToken, newConfig.SrcToken)
+	if err != nil {
+		return fmt.Errorf("source token: %v", err)
+	}
+	err = checkTokenIdentityUnchanged(oldConfig.DestToken, newConfig.DestToken)
+	if err != nil {
+		return fmt.Errorf("destination token: %v", err)
+	}
+	return nil
+}
+
+func checkTokenIdentityUnchanged(oldConfig, newConfig *TokenConfig) error {
+	switch {
+	case !strings.EqualFold(oldConfig.ContractAddress, newConfig.ContractAddress):
+		return fmt.Errorf("can not change 'ContractAddress' from '%v' to '%v'", oldConfig.ContractAddress, newConfig.ContractAddress)
+	case !strings.EqualFold(oldConfig.DepositAddress, newConfig.DepositAddress):
+		return fmt.Errorf("can not change 'DepositAddress' from '%v' to '%v'", oldConfig.DepositAddress, newConfig.DepositAddress)
+	case !strings.EqualFold(oldConfig.DcrmAddress, newConfig.DcrmAddress):
+		return fmt.Errorf("can not change 'DcrmAddress' from '%v' to '%v'", oldConfig.DcrmAddress, newConfig.DcrmAddress)
+	case *oldConfig.Decimals != *newConfig.Decimals:
+		return fmt.Errorf("can not change 'Decimals' from %v to %v", *oldConfig.Decimals, *newConfig.Decimals)
+	}
+	return nil
+}
@@ -1,14 +1,15 @@
 package tools
 
 import (
-	"errors"
 	"time"
 
 	"github.com/anyswap/CrossChain-Bridge/dcrm"
+	"github.com/anyswap/CrossChain-Bridge/internal/metrics"
 	"github.com/anyswap/CrossChain-Bridge/log"
 	"github.com/anyswap/CrossChain-Bridge/mongodb"
 	"github.com/anyswap/CrossChain-Bridge/params"
-	"github.com/anyswap/CrossChain-Bridge/rpc/client"
+	"github.com/anyswap/CrossChain-Bridge/rpc/apierrors"
+	"github.com/anyswap/CrossChain-Bridge/rpc/swapclient"
 	"github.com/anyswap/CrossChain-Bridge/tokens"
 )
 
@@ -17,28 +18,29 @@ var (
 	retryRPCInterval = 1 * time.Second
 )
 
+func getServerClient() *swapclient.Client {
+	return swapclient.NewClient(params.ServerAPIAddress)
+}
+
 // IsSwapExist is swapin exist
 func IsSwapExist(txid, pairID, bind string, isSwapin bool) bool {
 	if mongodb.HasSession() {
 		swap, _ := mongodb.FindSwap(isSwapin, txid, pairID, bind)
 		return swap != nil
 	}
-	var result interface{}
-	var method string
-	if isSwapin {
-		method = "swap.GetSwapin"
-	} else {
-		method = "swap.GetSwapout"
-	}
-	args := map[string]interface{}{
-		"txid":   txid,
-		"pairid": pairID,
-		"bind":   bind,
-	}
+	serverClient := getServerClient()
 	for i := 0; i < retryRPCCount; i++ {
-		err := client.RPCPost(&result, params.ServerAPIAddress, method, args)
+		var err error
+		if isSwapin {
+			_, err = serverClient.GetSwapin(txid, pairID, bind)
+		} else {
+			_, err = serverClient.GetSwapout(txid, pairID, bind)
+		}
 		if err == nil {
-			return result != nil
+			return true
+		}
+		if apierrors.Is(err, mongodb.ErrSwapNotFound) {
+			return false
 		}
 		time.Sleep(retryRPCInterval)
 	}
@@ -94,19 +96,14 @@ func registerSwap(isSwapin bool, txid string, swapInfos []*tokens.TxSwapInfo, ve
 				_ = mongodb.AddSwapout(swap)
 			}
 		} else {
-			var method string
-			if isSwapin {
-				method = "swap.Swapin"
-			} else {
-				method = "swap.Swapout"
-			}
-			args := map[string]interface{}{
-				"txid":   txid,
-				"pairid": pairID,
-			}
-			var result interface{}
+			serverClient := getServerClient()
 			for i := 0; i < retryRPCCount; i++ {
-				err := client.RPCPost(&result, params.ServerAPIAddress, method, args)
+				var err error
+				if isSwapin {
+					_, err = serverClient.Swapin(txid, pairID)
+				} else {
+					_, err = serverClient.Swapout(txid, pairID)
+				}
 				if tokens.ShouldRegisterSwapForError(err) ||
 					IsSwapAlreadyExistRegisterError(err) {
 					break
@@ -119,7 +116,7 @@ func registerSwap(isSwapin bool, txid string, swapInfos []*tokens.TxSwapInfo, ve
 
 // IsSwapAlreadyExistRegisterError is err of swap already exist
 func IsSwapAlreadyExistRegisterError(err error) bool {
-	return errors.Is(err, mongodb.ErrItemIsDup)
+	return apierrors.Is(err, mongodb.ErrItemIsDup)
 }
 
 // RegisterP2shSwapin register p2sh swapin
@@ -147,13 +144,9 @@ func RegisterP2shSwapin(txid string, swapInfo *tokens.TxSwapInfo, verifyError er
 		}
 		_ = mongodb.AddSwapin(swap)
 	} else {
-		args := map[string]interface{}{
-			"txid": txid,
-			"bind": bind,
-		}
-		var result interface{}
+		serverClient := getServerClient()
 		for i := 0; i < retryRPCCount; i++ {
-			err := client.RPCPost(&result, params.ServerAPIAddress, "swap.P2shSwapin", args)
+			_, err := serverClient.P2shSwapin(txid, bind)
 			if tokens.ShouldRegisterSwapForError(err) ||
 				IsSwapAlreadyExistRegisterError(err) {
 				break
@@ -169,9 +162,9 @@ func GetP2shBindAddress(p2shAddress string) (bindAddress string) {
 		bindAddress, _ = mongodb.FindP2shBindAddress(p2shAddress)
 		return bindAddress
 	}
-	var result tokens.P2shAddressInfo
+	serverClient := getServerClient()
 	for i := 0; i < retryRPCCount; i++ {
-		err := client.RPCPost(&result, params.ServerAPIAddress, "swap.GetP2shAddressInfo", p2shAddress)
+		result, err := serverClient.GetP2shAddressInfo(p2shAddress)
 		if err == nil {
 			return result.BindAddress
 		}
@@ -193,9 +186,9 @@ func GetLatestScanHeight(isSrc bool) uint64 {
 			time.Sleep(1 * time.Second)
 		}
 	}
-	var result mongodb.MgoLatestScanInfo
+	serverClient := getServerClient()
 	for {
-		err := client.RPCPost(&result, params.ServerAPIAddress, "swap.GetLatestScanInfo", isSrc)
+		result, err := serverClient.GetLatestScanInfo(isSrc)
 		if err == nil {
 			height := result.BlockHeight
 			log.Info("GetLatestScanHeight", "isSrc", isSrc, "height", height)
@@ -220,6 +213,7 @@ func LoopGetLatestBlockNumber(b tokens.CrossChainBridge) uint64 {
 
 // UpdateLatestScanInfo update latest scan info
 func UpdateLatestScanInfo(isSrc bool, height uint64) error {
+	metrics.SetScannedBlockHeight(isSrc, height)
 	if dcrm.IsSwapServer() {
 		return mongodb.UpdateLatestScanInfo(isSrc, height)
 	}
@@ -232,11 +226,14 @@ func IsAddressRegistered(address string) bool {
 		result, _ := mongodb.FindRegisteredAddress(address)
 		return result != nil
 	}
-	var result interface{}
+	serverClient := getServerClient()
 	for i := 0; i < retryRPCCount; i++ {
-		err := client.RPCPost(&result, params.ServerAPIAddress, "swap.GetRegisteredAddress", address)
+		_, err := serverClient.GetRegisteredAddress(address)
 		if err == nil {
-			return result != nil
+			return true
+		}
+		if apierrors.Is(err, mongodb.ErrItemNotFound) {
+			return false
 		}
 		time.Sleep(retryRPCInterval)
 	}
@@ -2,10 +2,13 @@ package tokens
 
 import (
 	"crypto/ecdsa"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/anyswap/CrossChain-Bridge/common"
 	"github.com/anyswap/CrossChain-Bridge/tools"
@@ -39,6 +42,10 @@ type ChainConfig struct {
 	WaitTimeToReplace       int64  // seconds
 	MaxReplaceCount         int
 	EnableReplaceSwap       bool
+
+	// health check thresholds, use default value if 0
+	MaxBlockAge int64  `json:",omitempty"` // seconds
+	MaxScanLag  uint64 `json:",omitempty"` // blocks
 }
 
 // GatewayConfig struct
@@ -93,6 +100,19 @@ type TokenConfig struct {
 	DefaultGasLimit         uint64 `json:",omitempty"`
 	AllowSwapinFromContract bool   `json:",omitempty"`
 
+	// key rotation, the retiring dcrm address only finishes pending swaps,
+	// then its remaining funds are swept to the active dcrm address
+	RetiringDcrmAddress string `json:",omitempty"`
+	RetiringDcrmPubkey  string `json:"-"`
+
+	// runtime swap state set by admin, overrides DisableSwap of config file
+	SwapState *SwapState `toml:"-" json:",omitempty"`
+	// scheduled maintenance windows set by admin, swap is paused during window
+	maintenanceWindows []*MaintenanceWindow
+	// runtime states (DisableSwap, SwapState and maintenance windows) are
+	// reloaded periodically, so access them with lock
+	runtimeStateLock sync.RWMutex
+
 	// use private key address instead
 	DcrmAddressKeyStore string `json:"-"`
 	DcrmAddressPassword string `json:"-"`
@@ -107,6 +127,93 @@ type TokenConfig struct {
 	bigValThreshhold *big.Int
 }
 
+// SwapState runtime swap state of token
+type SwapState struct {
+	DisableSwap bool
+	Reason      string `json:",omitempty"`
+	SetBy       string `json:",omitempty"`
+	Timestamp   int64
+}
+
+// SetSwapState set runtime swap state
+func (c *TokenConfig) SetSwapState(state *SwapState) {
+	c.runtimeStateLock.Lock()
+	defer c.runtimeStateLock.Unlock()
+	c.DisableSwap = state.DisableSwap
+	c.SwapState = state
+}
+
+// GetSwapState get runtime swap state, the returned state must not be modified
+func (c *TokenConfig) GetSwapState() *SwapState {
+	c.runtimeStateLock.RLock()
+	defer c.runtimeStateLock.RUnlock()
+	return c.SwapState
+}
+
+// IsSwapDisabled is swap disabled by config file or admin
+func (c *TokenConfig) IsSwapDisabled() bool {
+	c.runtimeStateLock.RLock()
+	defer c.runtimeStateLock.RUnlock()
+	return c.DisableSwap
+}
+
+// MarshalJSON json marshal with runtime states read under lock
+func (c *TokenConfig) MarshalJSON() ([]byte, error) {
+	type tokenConfig TokenConfig // without MarshalJSON method
+	c.runtimeStateLock.RLock()
+	defer c.runtimeStateLock.RUnlock()
+	return json.Marshal((*tokenConfig)(c))
+}
+
+// inherit runtime states set by admin from the replaced token config
+func (c *TokenConfig) inheritRuntimeState(old *TokenConfig) {
+	if state := old.GetSwapState(); state != nil {
+		c.SetSwapState(state)
+	}
+	c.SetMaintenanceWindows(old.GetMaintenanceWindows())
+}
+
+// MaintenanceWindow scheduled maintenance window of token pair
+type MaintenanceWindow struct {
+	ID        string
+	PairID    string
+	Direction string // deposit, withdraw or both
+	StartTime int64  // unix seconds, inclusive
+	EndTime   int64  // unix seconds, exclusive
+	Reason    string `json:",omitempty"`
+	CreatedBy string `json:",omitempty"`
+}
+
+// IsActive is maintenance window active at timestamp
+func (w *MaintenanceWindow) IsActive(timestamp int64) bool {
+	return timestamp >= w.StartTime && timestamp < w.EndTime
+}
+
+// SetMaintenanceWindows set maintenance windows which are not ended
+func (c *TokenConfig) SetMaintenanceWindows(windows []*MaintenanceWindow) {
+	c.runtimeStateLock.Lock()
+	defer c.runtimeStateLock.Unlock()
+	c.maintenanceWindows = windows
+}
+
+// GetMaintenanceWindows get maintenance windows, the returned slice must not be modified
+func (c *TokenConfig) GetMaintenanceWindows() []*MaintenanceWindow {
+	c.runtimeStateLock.RLock()
+	defer c.runtimeStateLock.RUnlock()
+	return c.maintenanceWindows
+}
+
+// GetActiveMaintenanceWindow get maintenance window active now, returns nil if not in maintenance
+func (c *TokenConfig) GetActiveMaintenanceWindow() *MaintenanceWindow {
+	now := time.Now().Unix()
+	for _, window := range c.GetMaintenanceWindows() {
+		if window.IsActive(now) {
+			return window
+		}
+	}
+	return nil
+}
+
 // IsErc20 return if token is erc20
 func (c *TokenConfig) IsErc20() bool {
 	return strings.EqualFold(c.ID, "ERC20") || c.IsProxyErc20()
@@ -145,9 +252,10 @@ type SwapTxType uint32
 
 // SwapTxType constants
 const (
-	SwapinTx     SwapTxType = iota // 0
-	SwapoutTx                      // 1
-	P2shSwapinTx                   // 2
+	SwapinTx       SwapTxType = iota // 0
+	SwapoutTx                        // 1
+	P2shSwapinTx                     // 2
+	ManualPayoutTx                   // 3
 )
 
 func (s SwapTxType) String() string {
@@ -158,6 +266,8 @@ func (s SwapTxType) String() string {
 		return "swapouttx"
 	case P2shSwapinTx:
 		return "p2shswapintx"
+	case ManualPayoutTx:
+		return "manualpayouttx"
 	default:
 		return fmt.Sprintf("unknown swaptx type %d", s)
 	}
@@ -193,6 +303,8 @@ type SwapInfo struct {
 	TxType     SwapTxType `json:"txtype,omitempty"`
 	Bind       string     `json:"bind,omitempty"`
 	Identifier string     `json:"identifier,omitempty"`
+	AdminTx    string     `json:"admintx,omitempty"`    // admin signed call of manual payout
+	ApproveTxs []string   `json:"approvetxs,omitempty"` // admin signed approve txs of manual payout
 }
 
 // BuildTxArgs struct
@@ -211,6 +323,7 @@ type BuildTxArgs struct {
 func (args *BuildTxArgs) GetExtraArgs() *BuildTxArgs {
 	return &BuildTxArgs{
 		SwapInfo: args.SwapInfo,
+		From:     args.From,
 		Extra:    args.Extra,
 	}
 }
@@ -343,7 +456,11 @@ func (c *TokenConfig) CheckConfig(isSrc bool) error {
 	if err != nil {
 		return err
 	}
-	return c.VerifyDcrmPublicKey()
+	err = c.VerifyDcrmPublicKey()
+	if err != nil {
+		return err
+	}
+	return c.VerifyRetiringDcrmPublicKey()
 }
 
 // CalcAndStoreValue calc and store value (minus duplicate calculation)
@@ -400,8 +517,29 @@ func (c *TokenConfig) VerifyDcrmPublicKey() error {
 	if c.dcrmAddressPriKey != nil && c.DcrmPubkey == "" {
 		return nil
 	}
-	// ETH like address
-	pkBytes := common.FromHex(c.DcrmPubkey)
+	return verifyPublicKeyAddress(c.DcrmPubkey, c.DcrmAddress)
+}
+
+// VerifyRetiringDcrmPublicKey verify public key of retiring dcrm address
+func (c *TokenConfig) VerifyRetiringDcrmPublicKey() error {
+	if c.RetiringDcrmAddress == "" {
+		return nil
+	}
+	if c.RetiringDcrmPubkey == "" {
+		return fmt.Errorf("token must config 'RetiringDcrmPubkey' if 'RetiringDcrmAddress' is configed")
+	}
+	if strings.EqualFold(c.RetiringDcrmAddress, c.DcrmAddress) {
+		return fmt.Errorf("retiring dcrm address is the same as dcrm address")
+	}
+	if !common.IsHexAddress(c.RetiringDcrmAddress) {
+		return nil
+	}
+	return verifyPublicKeyAddress(c.RetiringDcrmPubkey, c.RetiringDcrmAddress)
+}
+
+// ETH like address
+func verifyPublicKeyAddress(publicKey, address string) error {
+	pkBytes := common.FromHex(publicKey)
 	if len(pkBytes) != 65 || pkBytes[0] != 4 {
 		return fmt.Errorf("wrong dcrm public key, shoule be uncompressed")
 	}
@@ -411,8 +549,46 @@ func (c *TokenConfig) VerifyDcrmPublicKey() error {
 		Y:     new(big.Int).SetBytes(pkBytes[33:65]),
 	}
 	pubAddr := crypto.PubkeyToAddress(pubKey)
-	if !strings.EqualFold(pubAddr.String(), c.DcrmAddress) {
-		return fmt.Errorf("dcrm address %v and public key address %v is not match", c.DcrmAddress, pubAddr.String())
+	if !strings.EqualFold(pubAddr.String(), address) {
+		return fmt.Errorf("dcrm address %v and public key address %v is not match", address, pubAddr.String())
 	}
 	return nil
 }
+
+// IsMigrating returns if the dcrm address is in rotation
+func (c *TokenConfig) IsMigrating() bool {
+	return c.RetiringDcrmAddress != ""
+}
+
+// IsRetiringDcrmAddress returns if address is the retiring dcrm address
+func (c *TokenConfig) IsRetiringDcrmAddress(address string) bool {
+	return c.RetiringDcrmAddress != "" && strings.EqualFold(address, c.RetiringDcrmAddress)
+}
+
+// GetDcrmPubkeyOf get public key of the active or retiring dcrm address
+func (c *TokenConfig) GetDcrmPubkeyOf(address string) string {
+	if c.IsRetiringDcrmAddress(address) {
+		return c.RetiringDcrmPubkey
+	}
+	return c.DcrmPubkey
+}
+
+// GetSwapSigner get the dcrm address which signed the swap tx,
+// swaps without recorded signer are signed before the rotation
+func (c *TokenConfig) GetSwapSigner(swapFrom string) string {
+	if swapFrom != "" {
+		return swapFrom
+	}
+	if c.IsMigrating() {
+		return c.RetiringDcrmAddress
+	}
+	return c.DcrmAddress
+}
+
+// GetDcrmAddressOf get the active or retiring dcrm address which matches address
+func (c *TokenConfig) GetDcrmAddressOf(address string) string {
+	if c.IsRetiringDcrmAddress(address) {
+		return c.RetiringDcrmAddress
+	}
+	return c.DcrmAddress
+}
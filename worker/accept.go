@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/anyswap/CrossChain-Bridge/dcrm"
+	"github.com/anyswap/CrossChain-Bridge/internal/metrics"
 	"github.com/anyswap/CrossChain-Bridge/params"
 	"github.com/anyswap/CrossChain-Bridge/tokens"
 	"github.com/anyswap/CrossChain-Bridge/tokens/btc"
@@ -81,6 +82,7 @@ func acceptSign() {
 			if err != nil {
 				logWorkerError("accept", "accept sign job failed", err, "keyID", keyID, "result", res)
 			} else {
+				metrics.IncOracleAcceptSign(agreeResult)
 				logWorker("accept", "accept sign job finish", "keyID", keyID, "result", agreeResult)
 				addAcceptSignHistory(keyID, agreeResult, info.MsgHash, info.MsgContext)
 			}
@@ -95,11 +97,26 @@ func verifySignInfo(signInfo *dcrm.SignInfoData) error {
 	}
 	msgHash := signInfo.MsgHash
 	msgContext := signInfo.MsgContext
-	if len(msgContext) != 1 {
+	switch {
+	case len(msgContext) == 1:
+		return verifySignInfoWithContext(msgHash, msgContext[0], false)
+	case len(msgContext) > 1 && len(msgContext) == len(msgHash):
+		// batch sign, every msg context corresponds to one msg hash
+		for i, context := range msgContext {
+			err := verifySignInfoWithContext(msgHash[i:i+1], context, true)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
 		return errWrongMsgContext
 	}
+}
+
+func verifySignInfoWithContext(msgHash []string, msgContext string, isBatch bool) error {
 	var args tokens.BuildTxArgs
-	err := json.Unmarshal([]byte(msgContext[0]), &args)
+	err := json.Unmarshal([]byte(msgContext), &args)
 	if err != nil {
 		return errWrongMsgContext
 	}
@@ -107,11 +124,23 @@ func verifySignInfo(signInfo *dcrm.SignInfoData) error {
 	case params.GetIdentifier():
 	case params.GetReplaceIdentifier():
 	case tokens.AggregateIdentifier:
+		if isBatch {
+			return errWrongMsgContext
+		}
 		if btc.BridgeInstance == nil {
 			return tokens.ErrNoBtcBridge
 		}
 		logWorker("accept", "verifySignInfo", "msgHash", msgHash, "msgContext", msgContext)
 		return btc.BridgeInstance.VerifyAggregateMsgHash(msgHash, &args)
+	case tokens.SweepIdentifier:
+		if isBatch {
+			return errWrongMsgContext
+		}
+		logWorker("accept", "verifySignInfo", "msgHash", msgHash, "msgContext", msgContext)
+		return verifySweepMsgHash(msgHash, &args)
+	case tokens.PayoutIdentifier:
+		logWorker("accept", "verifySignInfo", "msgHash", msgHash, "msgContext", msgContext)
+		return verifyPayoutMsgHash(msgHash, &args)
 	default:
 		return errIdentifierMismatch
 	}
@@ -145,7 +174,7 @@ func rebuildAndVerifyMsgHash(msgHash []string, args *tokens.BuildTxArgs) error {
 
 	buildTxArgs := &tokens.BuildTxArgs{
 		SwapInfo:    args.SwapInfo,
-		From:        tokenCfg.DcrmAddress,
+		From:        tokenCfg.GetDcrmAddressOf(args.From),
 		OriginValue: swapInfo.Value,
 		Extra:       args.Extra,
 	}
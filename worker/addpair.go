@@ -1,15 +1,20 @@
 package worker
 
 import (
+	"encoding/json"
 	"os"
 	"strings"
 
 	"github.com/anyswap/CrossChain-Bridge/log"
+	"github.com/anyswap/CrossChain-Bridge/mongodb"
 	"github.com/anyswap/CrossChain-Bridge/tokens"
 	"github.com/fsnotify/fsnotify"
 )
 
-// AddTokenPairDynamically add token pair dynamically
+const fileWatcher = "filewatcher"
+
+// AddTokenPairDynamically add, update or remove token pair dynamically
+// according to the changes of config files in token pairs dir
 func AddTokenPairDynamically() {
 	pairsDir := tokens.GetTokenPairsDir()
 	if pairsDir == "" {
@@ -30,11 +35,6 @@ func AddTokenPairDynamically() {
 		return
 	}
 
-	ops := []fsnotify.Op{
-		fsnotify.Create,
-		fsnotify.Write,
-	}
-
 	for {
 		select {
 		case ev, ok := <-watch.Events:
@@ -42,13 +42,16 @@ func AddTokenPairDynamically() {
 				continue
 			}
 			log.Trace("fsnotify watch event", "event", ev)
-			for _, op := range ops {
-				if ev.Op&op == op {
-					err = addTokenPair(ev.Name)
-					if err != nil {
-						log.Info("addTokenPair error", "configFile", ev.Name, "err", err)
-					}
-					break
+			switch {
+			case ev.Op&fsnotify.Create == fsnotify.Create, ev.Op&fsnotify.Write == fsnotify.Write:
+				err = addOrUpdateTokenPair(ev.Name)
+				if err != nil {
+					log.Info("addOrUpdateTokenPair error", "configFile", ev.Name, "err", err)
+				}
+			case ev.Op&fsnotify.Remove == fsnotify.Remove, ev.Op&fsnotify.Rename == fsnotify.Rename:
+				err = removeTokenPairOfFile(ev.Name)
+				if err != nil {
+					log.Info("removeTokenPairOfFile error", "configFile", ev.Name, "err", err)
 				}
 			}
 		case werr, ok := <-watch.Errors:
@@ -60,7 +63,7 @@ func AddTokenPairDynamically() {
 	}
 }
 
-func addTokenPair(fileName string) error {
+func addOrUpdateTokenPair(fileName string) error {
 	if !strings.HasSuffix(fileName, ".toml") {
 		return nil
 	}
@@ -69,10 +72,95 @@ func addTokenPair(fileName string) error {
 	if err != nil || fileStat.IsDir() || fileStat.Size() == 0 {
 		return nil
 	}
-	pairConfig, err := tokens.AddPairConfig(fileName)
+	if tokens.GetPairIDOfConfigFile(fileName) != "" {
+		_, err = UpdateTokenPair(fileName, fileWatcher)
+	} else {
+		_, err = AddTokenPair(fileName, fileWatcher)
+	}
+	return err
+}
+
+func removeTokenPairOfFile(fileName string) error {
+	pairID := tokens.GetPairIDOfConfigFile(fileName)
+	if pairID == "" {
+		return nil
+	}
+	// rename by editors when saving file, the file is still there
+	if _, err := os.Stat(fileName); err == nil {
+		return nil
+	}
+	_, err := RemoveTokenPair(pairID, fileWatcher)
+	return err
+}
+
+// AddTokenPair add token pair through config file, and start its swap jobs
+func AddTokenPair(configFile, changedBy string) (*tokens.TokenPairConfig, error) {
+	pairConfig, err := tokens.AddPairConfig(configFile)
+	if err != nil {
+		return nil, err
+	}
+	swapJobStopChansLock.Lock()
+	startSwapJob := isSwapJobStarted
+	swapJobStopChansLock.Unlock()
+	if startSwapJob {
+		AddSwapJob(pairConfig)
+	}
+	recordPairConfigChange("add", pairConfig.PairID, configFile, changedBy, nil, pairConfig)
+	return pairConfig, nil
+}
+
+// UpdateTokenPair update token pair through config file,
+// the swap jobs of the pair use the new config from the next round
+func UpdateTokenPair(configFile, changedBy string) (*tokens.TokenPairConfig, error) {
+	oldConfig, newConfig, err := tokens.UpdatePairConfig(configFile)
+	if err != nil {
+		return nil, err
+	}
+	// file watcher may notify several times for one modification
+	if marshalPairConfig(oldConfig) == marshalPairConfig(newConfig) {
+		return newConfig, nil
+	}
+	recordPairConfigChange("update", newConfig.PairID, configFile, changedBy, oldConfig, newConfig)
+	return newConfig, nil
+}
+
+// RemoveTokenPair remove token pair, and stop its swap jobs
+func RemoveTokenPair(pairID, changedBy string) (*tokens.TokenPairConfig, error) {
+	pairConfig, err := tokens.RemovePairConfig(pairID)
+	if err != nil {
+		return nil, err
+	}
+	RemoveSwapJob(pairID)
+	recordPairConfigChange("remove", pairConfig.PairID, "", changedBy, pairConfig, nil)
+	return pairConfig, nil
+}
+
+func recordPairConfigChange(operation, pairID, configFile, changedBy string, oldConfig, newConfig *tokens.TokenPairConfig) {
+	logWorker("pairconfig", "token pair config changed", "operation", operation, "pairID", pairID, "configFile", configFile, "changedBy", changedBy)
+	if !mongodb.HasSession() {
+		return
+	}
+	change := &mongodb.MgoPairConfigChange{
+		PairID:     pairID,
+		Operation:  operation,
+		ConfigFile: configFile,
+		ChangedBy:  changedBy,
+		OldConfig:  marshalPairConfig(oldConfig),
+		NewConfig:  marshalPairConfig(newConfig),
+	}
+	err := mongodb.AddPairConfigChange(change)
+	if err != nil {
+		logWorkerError("pairconfig", "record token pair config change failed", err, "operation", operation, "pairID", pairID)
+	}
+}
+
+func marshalPairConfig(pairConfig *tokens.TokenPairConfig) string {
+	if pairConfig == nil {
+		return ""
+	}
+	data, err := json.Marshal(pairConfig)
 	if err != nil {
-		return err
+		return ""
 	}
-	log.Info("addTokenPair success", "configFile", fileName, "pairID", pairConfig.PairID)
-	return nil
+	return string(data)
 }
@@ -25,6 +25,7 @@ func StartAggregateJob() {
 	}
 
 	for loop := 1; ; loop++ {
+		markJobAlive("aggregate")
 		logWorker("aggregate", "start aggregate job", "loop", loop)
 		doAggregateJob()
 		logWorker("aggregate", "finish aggregate job", "loop", loop)
@@ -0,0 +1,24 @@
+package worker
+
+import (
+	"time"
+
+	"github.com/anyswap/CrossChain-Bridge/mongodb"
+)
+
+var blacklistJobInterval = 10 * time.Minute
+
+// StartBlacklistJob archive expired blacklist entries periodically,
+// expired entries are already ignored when querying blacklist.
+func StartBlacklistJob() {
+	for {
+		markJobAlive("blacklist")
+		archived, err := mongodb.ArchiveExpiredBlacklist()
+		if err != nil {
+			logWorkerError("blacklist", "archive expired blacklist failed", err)
+		} else if archived > 0 {
+			logWorker("blacklist", "archive expired blacklist success", "count", archived)
+		}
+		restInJob(blacklistJobInterval)
+	}
+}
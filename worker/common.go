@@ -18,6 +18,7 @@ type MatchTx struct {
 	SwapValue  string
 	SwapType   tokens.SwapType
 	SwapNonce  uint64
+	SwapFrom   string
 }
 
 func addInitialSwapResult(swapInfo *tokens.TxSwapInfo, status mongodb.SwapStatus, isSwapin bool) (err error) {
@@ -70,6 +71,7 @@ func updateSwapResult(txid, pairID, bind string, mtx *MatchTx) (err error) {
 		updates.SwapTx = mtx.SwapTx
 		updates.SwapValue = mtx.SwapValue
 		updates.SwapNonce = mtx.SwapNonce
+		updates.SwapFrom = mtx.SwapFrom
 		updates.SwapHeight = 0
 		updates.SwapTime = 0
 	} else {
@@ -0,0 +1,59 @@
+package worker
+
+import (
+	"strings"
+	"time"
+
+	"github.com/anyswap/CrossChain-Bridge/internal/swapapi"
+	"github.com/anyswap/CrossChain-Bridge/log"
+	"github.com/anyswap/CrossChain-Bridge/mongodb"
+	"github.com/anyswap/CrossChain-Bridge/tokens"
+)
+
+var maintenanceJobInterval = 30 * time.Second
+
+// LoadMaintenanceWindows load maintenance windows which are not ended from database,
+// and set them to the token configs of their pair and direction
+func LoadMaintenanceWindows() {
+	windows, err := mongodb.FindMaintenanceWindows(time.Now().Unix())
+	if err != nil {
+		log.Warn("load maintenance windows failed", "err", err)
+		return
+	}
+	srcWindows := make(map[string][]*tokens.MaintenanceWindow)
+	dstWindows := make(map[string][]*tokens.MaintenanceWindow)
+	for _, mw := range windows {
+		window := &tokens.MaintenanceWindow{
+			ID:        mw.Key,
+			PairID:    mw.PairID,
+			Direction: mw.Direction,
+			StartTime: mw.StartTime,
+			EndTime:   mw.EndTime,
+			Reason:    mw.Reason,
+			CreatedBy: mw.CreatedBy,
+		}
+		if mw.Direction != "withdraw" {
+			srcWindows[mw.PairID] = append(srcWindows[mw.PairID], window)
+		}
+		if mw.Direction != "deposit" {
+			dstWindows[mw.PairID] = append(dstWindows[mw.PairID], window)
+		}
+	}
+	for _, pairCfg := range tokens.GetTokenPairsConfig() {
+		pairID := strings.ToLower(pairCfg.PairID)
+		pairCfg.SrcToken.SetMaintenanceWindows(srcWindows[pairID])
+		pairCfg.DestToken.SetMaintenanceWindows(dstWindows[pairID])
+	}
+}
+
+// StartMaintenanceJob sync maintenance windows periodically, so that windows
+// set on other server nodes take effect and ended windows are removed,
+// and register the swaps queued during the ended windows.
+func StartMaintenanceJob() {
+	for {
+		markJobAlive("maintenance")
+		LoadMaintenanceWindows()
+		swapapi.ProcessQueuedRegisters()
+		time.Sleep(maintenanceJobInterval)
+	}
+}
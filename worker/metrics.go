@@ -0,0 +1,45 @@
+package worker
+
+import (
+	"time"
+
+	"github.com/anyswap/CrossChain-Bridge/internal/metrics"
+	"github.com/anyswap/CrossChain-Bridge/mongodb"
+)
+
+var swapMetricsJobInterval = time.Minute
+
+// StartSwapMetricsJob update metrics of swap counts per pair and status periodically
+func StartSwapMetricsJob() {
+	for {
+		markJobAlive("swapmetrics")
+		counts, err := getSwapCounts()
+		if err != nil {
+			logWorkerError("swapmetrics", "get swap counts failed", err)
+		} else {
+			metrics.SetSwapCounts(counts)
+		}
+		restInJob(swapMetricsJobInterval)
+	}
+}
+
+func getSwapCounts() (counts []*metrics.SwapCount, err error) {
+	for _, isSwapin := range []bool{true, false} {
+		for _, isResult := range []bool{false, true} {
+			items, err := mongodb.GetSwapStatusCounts(isSwapin, isResult)
+			if err != nil {
+				return nil, err
+			}
+			for _, item := range items {
+				counts = append(counts, &metrics.SwapCount{
+					PairID:   item.PairID,
+					IsSwapin: isSwapin,
+					IsResult: isResult,
+					Status:   item.Status.String(),
+					Count:    item.Count,
+				})
+			}
+		}
+	}
+	return counts, nil
+}
@@ -0,0 +1,151 @@
+package worker
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/anyswap/CrossChain-Bridge/mongodb"
+	"github.com/anyswap/CrossChain-Bridge/tokens"
+)
+
+var (
+	migrateInterval = 10 * time.Minute
+
+	migrationStatus     = make(map[string]*MigrationStatus) // key is pairID:src/dest
+	migrationStatusLock sync.RWMutex
+)
+
+// MigrationStatus dcrm address migration status of a token
+type MigrationStatus struct {
+	PairID          string
+	IsSrc           bool
+	ActiveAddress   string
+	RetiringAddress string
+	LastSweepTx     string `json:",omitempty"`
+	LastSweepTime   int64  `json:",omitempty"`
+	LastError       string `json:",omitempty"`
+	LastCheckTime   int64
+	Finished        bool
+}
+
+// StartMigrateJob sweep retiring dcrm addresses job
+func StartMigrateJob() {
+	for loop := 1; ; loop++ {
+		markJobAlive("migrate")
+		logWorker("migrate", "start migrate job", "loop", loop)
+		doMigrateJob()
+		logWorker("migrate", "finish migrate job", "loop", loop)
+		time.Sleep(migrateInterval)
+	}
+}
+
+func doMigrateJob() {
+	for _, pairCfg := range tokens.GetTokenPairsConfig() {
+		sweepRetiringDcrmAddress(pairCfg.PairID, pairCfg.SrcToken, true)
+		sweepRetiringDcrmAddress(pairCfg.PairID, pairCfg.DestToken, false)
+	}
+}
+
+func sweepRetiringDcrmAddress(pairID string, tokenCfg *tokens.TokenConfig, isSrc bool) {
+	if tokenCfg == nil || !tokenCfg.IsMigrating() {
+		return
+	}
+	sweeper, ok := tokens.GetCrossChainBridge(isSrc).(tokens.Sweeper)
+	if !ok {
+		return
+	}
+	status := getOrCreateMigrationStatus(pairID, tokenCfg, isSrc)
+	// wait swaps sent from the retiring dcrm address to be stable,
+	// as sweeping may spend the utxos of pending or replacing swap txs
+	hasUnstable, err := mongodb.HasUnstableSwapResultsFrom(tokenCfg.RetiringDcrmAddress)
+	var txHash string
+	switch {
+	case err != nil:
+	case hasUnstable:
+		err = tokens.ErrRetiringHasPendingTxs
+	default:
+		txHash, err = sweeper.SweepRetiringDcrmAddress(pairID)
+	}
+
+	migrationStatusLock.Lock()
+	defer migrationStatusLock.Unlock()
+	status.LastCheckTime = time.Now().Unix()
+	switch err {
+	case nil:
+		status.LastSweepTx = txHash
+		status.LastSweepTime = status.LastCheckTime
+		status.LastError = ""
+		status.Finished = false
+		logWorker("migrate", "sweep retiring dcrm address success", "pairID", pairID, "isSrc", isSrc, "txHash", txHash)
+	case tokens.ErrNothingToSweep:
+		status.LastError = ""
+		status.Finished = true
+	default:
+		status.LastError = err.Error()
+		logWorkerError("migrate", "sweep retiring dcrm address failed", err, "pairID", pairID, "isSrc", isSrc)
+	}
+}
+
+func getMigrationStatusKey(pairID string, isSrc bool) string {
+	if isSrc {
+		return pairID + ":src"
+	}
+	return pairID + ":dest"
+}
+
+func getOrCreateMigrationStatus(pairID string, tokenCfg *tokens.TokenConfig, isSrc bool) *MigrationStatus {
+	key := getMigrationStatusKey(pairID, isSrc)
+	migrationStatusLock.Lock()
+	defer migrationStatusLock.Unlock()
+	status, exist := migrationStatus[key]
+	if !exist || status.RetiringAddress != tokenCfg.RetiringDcrmAddress {
+		status = &MigrationStatus{
+			PairID:          pairID,
+			IsSrc:           isSrc,
+			ActiveAddress:   tokenCfg.DcrmAddress,
+			RetiringAddress: tokenCfg.RetiringDcrmAddress,
+		}
+		migrationStatus[key] = status
+	}
+	return status
+}
+
+// GetMigrationStatus get dcrm address migration status of all migrating tokens
+func GetMigrationStatus() []*MigrationStatus {
+	migrationStatusLock.RLock()
+	defer migrationStatusLock.RUnlock()
+	result := make([]*MigrationStatus, 0, len(migrationStatus))
+	for _, status := range migrationStatus {
+		item := *status
+		result = append(result, &item)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].PairID != result[j].PairID {
+			return result[i].PairID < result[j].PairID
+		}
+		return result[i].IsSrc && !result[j].IsSrc
+	})
+	return result
+}
+
+func verifySweepMsgHash(msgHash []string, args *tokens.BuildTxArgs) error {
+	pairCfg := tokens.GetTokenPairConfig(args.PairID)
+	if pairCfg == nil {
+		return tokens.ErrUnknownPairID
+	}
+	var isSrc bool
+	switch {
+	case pairCfg.SrcToken != nil && pairCfg.SrcToken.IsRetiringDcrmAddress(args.From):
+		isSrc = true
+	case pairCfg.DestToken != nil && pairCfg.DestToken.IsRetiringDcrmAddress(args.From):
+		isSrc = false
+	default:
+		return tokens.ErrNotMigrating
+	}
+	sweeper, ok := tokens.GetCrossChainBridge(isSrc).(tokens.Sweeper)
+	if !ok {
+		return errWrongMsgContext
+	}
+	return sweeper.VerifySweepMsgHash(msgHash, args)
+}
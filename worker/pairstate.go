@@ -0,0 +1,55 @@
+package worker
+
+import (
+	"time"
+
+	"github.com/anyswap/CrossChain-Bridge/log"
+	"github.com/anyswap/CrossChain-Bridge/mongodb"
+	"github.com/anyswap/CrossChain-Bridge/tokens"
+)
+
+var syncPairStatesInterval = 30 * time.Second
+
+// LoadPairStates load token pair swap states set by admin from database,
+// and apply them on top of the token pair configs
+func LoadPairStates() {
+	pairStates, err := mongodb.FindPairStates()
+	if err != nil {
+		log.Warn("load pair states failed", "err", err)
+		return
+	}
+	for _, ps := range pairStates {
+		pairCfg := tokens.GetTokenPairConfig(ps.Key)
+		if pairCfg == nil {
+			continue
+		}
+		applySwapState(ps.Key, "deposit", pairCfg.SrcToken, ps.Deposit)
+		applySwapState(ps.Key, "withdraw", pairCfg.DestToken, ps.Withdraw)
+	}
+}
+
+func applySwapState(pairID, direction string, tokenCfg *tokens.TokenConfig, state *mongodb.MgoSwapState) {
+	if state == nil {
+		return
+	}
+	if old := tokenCfg.GetSwapState(); old != nil && old.Timestamp == state.Timestamp && old.DisableSwap == state.DisableSwap {
+		return
+	}
+	log.Info("apply pair state", "pairID", pairID, "direction", direction, "disable", state.DisableSwap, "reason", state.Reason, "setby", state.SetBy)
+	tokenCfg.SetSwapState(&tokens.SwapState{
+		DisableSwap: state.DisableSwap,
+		Reason:      state.Reason,
+		SetBy:       state.SetBy,
+		Timestamp:   state.Timestamp,
+	})
+}
+
+// StartSyncPairStatesJob sync pair states periodically,
+// so that states set on other server nodes take effect
+func StartSyncPairStatesJob() {
+	for {
+		time.Sleep(syncPairStatesInterval)
+		markJobAlive("sync_pair_states")
+		LoadPairStates()
+	}
+}
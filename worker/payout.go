@@ -0,0 +1,311 @@
+package worker
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/anyswap/CrossChain-Bridge/admin"
+	"github.com/anyswap/CrossChain-Bridge/common"
+	"github.com/anyswap/CrossChain-Bridge/mongodb"
+	"github.com/anyswap/CrossChain-Bridge/params"
+	"github.com/anyswap/CrossChain-Bridge/tokens"
+)
+
+var (
+	errPayoutWithoutAdminTx  = errors.New("manual payout without admin tx")
+	errPayoutMismatch        = errors.New("manual payout mismatch with admin call")
+	errPayoutApproveMismatch = errors.New("manual payout approve tx mismatch with admin call")
+	errPayoutOfValidSwap     = errors.New("original tx is valid swap, register it instead of manual payout")
+	errPaidOut               = errors.New("swap is already manual paid out")
+)
+
+// ManualPayoutArgs args of manual payout, the params of admin call are
+// '<swapin|swapout> <txid> <pairID> <to> <value>'
+type ManualPayoutArgs struct {
+	SwapType tokens.SwapType
+	TxID     string
+	PairID   string
+	To       string
+	Value    *big.Int // origin value, swap fee is deducted like normal swaps
+}
+
+// ParseManualPayoutParams parse params of manual payout admin call
+func ParseManualPayoutParams(callParams []string) (*ManualPayoutArgs, error) {
+	if len(callParams) != 5 {
+		return nil, fmt.Errorf("wrong number of params, have %v want 5", len(callParams))
+	}
+	payout := &ManualPayoutArgs{
+		TxID:   callParams[1],
+		PairID: strings.ToLower(callParams[2]),
+		To:     callParams[3],
+	}
+	switch callParams[0] {
+	case "swapin":
+		payout.SwapType = tokens.SwapinType
+	case "swapout":
+		payout.SwapType = tokens.SwapoutType
+	default:
+		return nil, fmt.Errorf("unknown swap type '%v'", callParams[0])
+	}
+	value, err := common.GetBigIntFromStr(callParams[4])
+	if err != nil || value.Sign() <= 0 {
+		return nil, fmt.Errorf("wrong value '%v'", callParams[4])
+	}
+	payout.Value = value
+	return payout, nil
+}
+
+// check the payout is sendable, and the original tx paid the bridge on source
+// chain but can not be swapped normally, and payout value is at most the paid value.
+func (payout *ManualPayoutArgs) verify() error {
+	isSwapin := payout.SwapType == tokens.SwapinType
+	fromTokenCfg, toTokenCfg := tokens.GetTokenConfigsByDirection(payout.PairID, isSwapin)
+	if fromTokenCfg == nil || toTokenCfg == nil {
+		return tokens.ErrUnknownPairID
+	}
+	if !tokens.GetCrossChainBridge(!isSwapin).IsValidAddress(payout.To) {
+		return fmt.Errorf("wrong payout address '%v'", payout.To)
+	}
+	if tokens.CalcSwappedValue(payout.PairID, payout.Value, isSwapin).Sign() <= 0 {
+		return tokens.ErrTxWithWrongValue
+	}
+	swapInfo, err := tokens.GetCrossChainBridge(isSwapin).VerifyTransaction(payout.PairID, payout.TxID, false)
+	switch {
+	case err == nil:
+		return errPayoutOfValidSwap
+	case swapInfo == nil || swapInfo.Value == nil || !canManualPayout(err):
+		return fmt.Errorf("original tx %v can not be paid out: %v", payout.TxID, err)
+	}
+	if payout.Value.Cmp(swapInfo.Value) > 0 {
+		return fmt.Errorf("payout value %v exceeds received value %v", payout.Value, swapInfo.Value)
+	}
+	return nil
+}
+
+// original tx paid the bridge, but is not swapped for these errors
+func canManualPayout(verifyErr error) bool {
+	switch verifyErr {
+	case tokens.ErrTxWithWrongMemo,
+		tokens.ErrTxWithWrongValue,
+		tokens.ErrBindAddrIsContract:
+		return true
+	default:
+		return false
+	}
+}
+
+// the original tx may be registered with other bind address, so check it
+// regardless of bind, only swaps in manual status can be paid out.
+func checkRegisteredSwapOfPayout(payout *ManualPayoutArgs) error {
+	isSwapin := payout.SwapType == tokens.SwapinType
+	txid, pairID := payout.TxID, payout.PairID
+	swap, err := mongodb.FindSwap(isSwapin, txid, pairID, "")
+	switch err {
+	case nil:
+		if !swap.Status.CanManualPayout() {
+			return fmt.Errorf("swap of txid %v pairID %v with status %v can not be paid out", txid, pairID, swap.Status)
+		}
+	case mongodb.ErrItemNotFound:
+	default:
+		return err
+	}
+	res, err := mongodb.FindSwapResult(isSwapin, txid, pairID, "")
+	switch err {
+	case nil:
+		if !res.Status.CanManualPayout() || res.SwapTx != "" {
+			return fmt.Errorf("swap result of txid %v pairID %v with status %v can not be paid out", txid, pairID, res.Status)
+		}
+	case mongodb.ErrItemNotFound:
+	default:
+		return err
+	}
+	return nil
+}
+
+// AddManualPayout add manual payout of swap which can not be processed normally
+// (eg. with wrong memo). the original swap (if registered) is kept in its
+// manual status and will not be swapped. the payout is recorded as swap and swap result of type
+// 'ManualPayoutTx', and processed by swap job like normal swaps.
+// approveTxs are the admin signed approve txs if the payout is proposed.
+func AddManualPayout(payout *ManualPayoutArgs, adminTx string, approveTxs []string, createdBy string) error {
+	if adminTx == "" {
+		return errPayoutWithoutAdminTx
+	}
+	err := payout.verify()
+	if err != nil {
+		return err
+	}
+	err = checkRegisteredSwapOfPayout(payout)
+	if err != nil {
+		return err
+	}
+	isSwapin := payout.SwapType == tokens.SwapinType
+	txid, pairID, bind := payout.TxID, payout.PairID, payout.To
+	err = mongodb.AddManualPayout(&mongodb.MgoManualPayout{
+		TxID:       txid,
+		PairID:     pairID,
+		Bind:       bind,
+		Value:      payout.Value.String(),
+		SwapType:   uint32(payout.SwapType),
+		AdminTx:    adminTx,
+		ApproveTxs: approveTxs,
+		CreatedBy:  createdBy,
+	})
+	if err == mongodb.ErrItemIsDup {
+		return fmt.Errorf("manual payout of txid %v pairID %v already exist", txid, pairID)
+	}
+	if err != nil {
+		return err
+	}
+	memo := "manual payout by " + strings.ToLower(createdBy)
+	// add swap result before swap, as swap job find swap result of swap
+	swapResult := &mongodb.MgoSwapResult{
+		PairID:    pairID,
+		TxID:      txid,
+		To:        bind,
+		Bind:      bind,
+		Value:     payout.Value.String(),
+		SwapValue: "0",
+		SwapType:  uint32(payout.SwapType),
+		Status:    mongodb.MatchTxEmpty,
+		Timestamp: now(),
+		Memo:      memo,
+	}
+	if isSwapin {
+		err = mongodb.AddSwapinResult(swapResult)
+	} else {
+		err = mongodb.AddSwapoutResult(swapResult)
+	}
+	if err != nil {
+		return err
+	}
+	swap := &mongodb.MgoSwap{
+		TxID:      txid,
+		PairID:    pairID,
+		TxType:    uint32(tokens.ManualPayoutTx),
+		Bind:      bind,
+		Status:    mongodb.TxNotSwapped,
+		Timestamp: now(),
+		Memo:      memo,
+	}
+	if isSwapin {
+		err = mongodb.AddSwapin(swap)
+	} else {
+		err = mongodb.AddSwapout(swap)
+	}
+	if err != nil {
+		return err
+	}
+	logWorker("payout", "add manual payout success", "txid", txid, "pairID", pairID, "bind", bind, "value", payout.Value, "isSwapin", isSwapin, "createdBy", createdBy)
+	return nil
+}
+
+// sign manual payout with payout identifier and admin tx, instead of the
+// normal identifier, so that oracles verify it with the admin tx.
+func setManualPayoutSwapInfo(args *tokens.BuildTxArgs) error {
+	payout, err := mongodb.FindManualPayout(args.SwapID, args.PairID)
+	if err != nil {
+		return err
+	}
+	if tokens.SwapType(payout.SwapType) != args.SwapType {
+		return fmt.Errorf("manual payout swap type mismatch, have %v want %v", args.SwapType, tokens.SwapType(payout.SwapType))
+	}
+	if !strings.EqualFold(payout.Bind, args.Bind) {
+		return fmt.Errorf("manual payout bind mismatch, have %v want %v", args.Bind, payout.Bind)
+	}
+	args.Identifier = tokens.PayoutIdentifier
+	args.AdminTx = payout.AdminTx
+	args.ApproveTxs = payout.ApproveTxs
+	return nil
+}
+
+// verify manual payout is signed by enough distinct admins allowed to payout,
+// the proposer signs the payout call and the others sign approve calls of it.
+func verifyPayoutApprovals(proposalID, proposer string, payout *ManualPayoutArgs, approveTxs []string) error {
+	operation := payout.SwapType.String()
+	pairIDs := []string{payout.PairID}
+	if !params.IsAdminMethodAllowed(proposer, params.AdminPayoutMethod, operation, pairIDs) {
+		return fmt.Errorf("manual payout is not signed by admin, signer is %v", proposer)
+	}
+	signers := map[string]struct{}{strings.ToLower(proposer): {}}
+	for _, approveTx := range approveTxs {
+		tx, err := admin.DecodeTransaction(approveTx)
+		if err != nil {
+			return err
+		}
+		sender, callArgs, err := admin.VerifySignature(tx)
+		if err != nil {
+			return err
+		}
+		if callArgs.Method != params.AdminProposalsMethod ||
+			len(callArgs.Params) != 2 ||
+			callArgs.Params[0] != "approve" ||
+			!strings.EqualFold(callArgs.Params[1], proposalID) {
+			return errPayoutApproveMismatch
+		}
+		signer := sender.String()
+		if !params.IsAdminMethodAllowed(signer, params.AdminPayoutMethod, operation, pairIDs) {
+			return fmt.Errorf("manual payout is approved by non admin %v", signer)
+		}
+		signers[strings.ToLower(signer)] = struct{}{}
+	}
+	threshold := params.GetAdminApprovalThreshold(params.AdminPayoutMethod, operation)
+	if len(signers) < threshold {
+		return fmt.Errorf("manual payout is not approved enough, have %v want %v", len(signers), threshold)
+	}
+	return nil
+}
+
+// verify manual payout is created by admin call, and rebuild the payout tx
+func verifyPayoutMsgHash(msgHash []string, args *tokens.BuildTxArgs) error {
+	if args.AdminTx == "" {
+		return errPayoutWithoutAdminTx
+	}
+	tx, err := admin.DecodeTransaction(args.AdminTx)
+	if err != nil {
+		return err
+	}
+	sender, callArgs, err := admin.VerifySignature(tx)
+	if err != nil {
+		return err
+	}
+	if callArgs.Method != params.AdminPayoutMethod {
+		return errPayoutMismatch
+	}
+	payout, err := ParseManualPayoutParams(callArgs.Params)
+	if err != nil {
+		return err
+	}
+	err = verifyPayoutApprovals(tx.Hash().Hex(), sender.String(), payout, args.ApproveTxs)
+	if err != nil {
+		return err
+	}
+	if args.TxType != tokens.ManualPayoutTx ||
+		args.SwapType != payout.SwapType ||
+		!strings.EqualFold(args.SwapID, payout.TxID) ||
+		!strings.EqualFold(args.PairID, payout.PairID) ||
+		!strings.EqualFold(args.Bind, payout.To) {
+		return errPayoutMismatch
+	}
+	err = payout.verify()
+	if err != nil {
+		return err
+	}
+
+	isSwapin := payout.SwapType == tokens.SwapinType
+	dstBridge := tokens.GetCrossChainBridge(!isSwapin)
+	tokenCfg := dstBridge.GetTokenConfig(args.PairID)
+	buildTxArgs := &tokens.BuildTxArgs{
+		SwapInfo:    args.SwapInfo,
+		From:        tokenCfg.GetDcrmAddressOf(args.From),
+		OriginValue: payout.Value,
+		Extra:       args.Extra,
+	}
+	rawTx, err := dstBridge.BuildRawTransaction(buildTxArgs)
+	if err != nil {
+		return err
+	}
+	return dstBridge.VerifyMsgHash(rawTx, msgHash)
+}
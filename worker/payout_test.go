@@ -0,0 +1,118 @@
+package worker
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/anyswap/CrossChain-Bridge/admin"
+	"github.com/anyswap/CrossChain-Bridge/common"
+	"github.com/anyswap/CrossChain-Bridge/params"
+	"github.com/anyswap/CrossChain-Bridge/tokens"
+	"github.com/anyswap/CrossChain-Bridge/tools/crypto"
+	"github.com/anyswap/CrossChain-Bridge/tools/rlp"
+	"github.com/anyswap/CrossChain-Bridge/types"
+)
+
+const testProposalID = "0x1111111111111111111111111111111111111111111111111111111111111111"
+
+// sign admin tx like 'admin.Sign' with the specified key
+func signTestAdminTx(t *testing.T, key *ecdsa.PrivateKey, method string, callParams ...string) string {
+	payload, err := json.Marshal(&admin.CallArgs{
+		Method:    method,
+		Params:    callParams,
+		Timestamp: time.Now().Unix(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := types.NewTransaction(0, common.HexToAddress("0x00000000000000000000000000000000000000cc"), big.NewInt(0), 0, big.NewInt(0), payload)
+	signedTx, err := types.SignTx(tx, types.MakeSigner("EIP155", big.NewInt(30300)), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	txdata, err := rlp.EncodeToBytes(signedTx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return common.ToHex(txdata)
+}
+
+func TestVerifyPayoutApprovals(t *testing.T) {
+	adminKey, _ := crypto.GenerateKey()
+	operatorKey1, _ := crypto.GenerateKey()
+	operatorKey2, _ := crypto.GenerateKey()
+	otherKey, _ := crypto.GenerateKey()
+	adminAddr := crypto.PubkeyToAddress(adminKey.PublicKey).String()
+	operator1 := crypto.PubkeyToAddress(operatorKey1.PublicKey).String()
+	operator2 := crypto.PubkeyToAddress(operatorKey2.PublicKey).String()
+
+	approve := func(key *ecdsa.PrivateKey) string {
+		return signTestAdminTx(t, key, params.AdminProposalsMethod, "approve", testProposalID)
+	}
+	adminApprove := approve(adminKey)
+	operatorApprove1 := approve(operatorKey1)
+	operatorApprove2 := approve(operatorKey2)
+	otherApprove := approve(otherKey)
+
+	swapinPayout := &ManualPayoutArgs{SwapType: tokens.SwapinType, PairID: "eth"}
+	swapoutPayout := &ManualPayoutArgs{SwapType: tokens.SwapoutType, PairID: "eth"}
+	otherPairPayout := &ManualPayoutArgs{SwapType: tokens.SwapinType, PairID: "btc"}
+
+	tests := []struct {
+		threshold  int // payout approval threshold in config, 0 if not configured
+		proposer   string
+		payout     *ManualPayoutArgs
+		approveTxs []string
+		isErr      bool
+	}{
+		{0, adminAddr, swapinPayout, []string{operatorApprove1}, false},
+		{0, operator1, swapinPayout, []string{operatorApprove2}, false},
+		{0, operator1, swapinPayout, []string{adminApprove, operatorApprove2}, false},
+		{1, adminAddr, swapinPayout, []string{operatorApprove1}, false},
+		{3, adminAddr, swapinPayout, []string{operatorApprove1, operatorApprove2}, false},
+		// threshold is at least 2 and signers must be distinct
+		{0, adminAddr, swapinPayout, nil, true},
+		{1, adminAddr, swapinPayout, nil, true},
+		{0, adminAddr, swapinPayout, []string{adminApprove}, true},
+		{0, operator1, swapinPayout, []string{operatorApprove1, operatorApprove1}, true},
+		{3, adminAddr, swapinPayout, []string{operatorApprove1}, true},
+		// proposer and approvers must be allowed to payout the swap type and pair
+		{0, operator1, swapoutPayout, []string{operatorApprove2}, true},
+		{0, operator1, otherPairPayout, []string{operatorApprove2}, true},
+		{0, adminAddr, swapoutPayout, []string{operatorApprove1}, true},
+		{0, adminAddr, swapinPayout, []string{otherApprove}, true},
+		// approve tx must approve the proposal
+		{0, adminAddr, swapinPayout, []string{signTestAdminTx(t, operatorKey1, params.AdminProposalsMethod, "approve", "0x1234")}, true},
+		{0, adminAddr, swapinPayout, []string{signTestAdminTx(t, operatorKey1, params.AdminProposalsMethod, "cancel", testProposalID)}, true},
+		{0, adminAddr, swapinPayout, []string{signTestAdminTx(t, operatorKey1, params.AdminPayoutMethod, "approve", testProposalID)}, true},
+		{0, adminAddr, swapinPayout, []string{"0x1234"}, true},
+	}
+
+	oldConfig := params.GetConfig()
+	defer params.SetConfig(oldConfig)
+	for i, test := range tests {
+		config := &params.ServerConfig{
+			Admins: []string{adminAddr},
+			AdminRoles: map[string]*params.AdminRole{
+				"payout": {
+					Members: []string{operator1, operator2},
+					Methods: []string{"payout:swapin"},
+					PairIDs: []string{"eth"},
+				},
+			},
+		}
+		if test.threshold != 0 {
+			config.AdminApproval = &params.AdminApprovalConfig{
+				Thresholds: map[string]int{params.AdminPayoutMethod: test.threshold},
+			}
+		}
+		params.SetConfig(config)
+		err := verifyPayoutApprovals(testProposalID, test.proposer, test.payout, test.approveTxs)
+		if isErr := err != nil; isErr != test.isErr {
+			t.Errorf("test %d: verifyPayoutApprovals isErr = %v, want %v, err = %v", i, isErr, test.isErr, err)
+		}
+	}
+}
@@ -41,6 +41,7 @@ func startReplaceSwapinJob() {
 		return
 	}
 	for {
+		markJobAlive("swapin_replace")
 		res, err := findSwapinsToReplace()
 		if err != nil {
 			logWorkerError("replace", "find swapins error", err)
@@ -60,6 +61,7 @@ func startReplaceSwapoutJob() {
 		return
 	}
 	for {
+		markJobAlive("swapout_replace")
 		res, err := findSwapoutsToReplace()
 		if err != nil {
 			logWorkerError("replace", "find swapouts error", err)
@@ -122,6 +124,9 @@ func dispatchReplaceTask(swap *mongodb.MgoSwapResult) {
 	logWorker("replace", "dispatch task", "swap", swap)
 	pairID := strings.ToLower(swap.PairID)
 	pairCfg := tokens.GetTokenPairConfig(pairID)
+	if pairCfg == nil { // pair is removed
+		return
+	}
 	isSwapin := tokens.SwapType(swap.SwapType) == tokens.SwapinType
 	if isSwapin {
 		swapinDcrmAddr := strings.ToLower(pairCfg.DestToken.DcrmAddress)
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"strings"
 
 	"github.com/anyswap/CrossChain-Bridge/common"
 	"github.com/anyswap/CrossChain-Bridge/mongodb"
@@ -64,7 +65,11 @@ func verifyReplaceSwap(txid, pairID, bind string, isSwapin bool) (*mongodb.MgoSw
 	if tokenCfg == nil {
 		return nil, nil, fmt.Errorf("no token config for pairID '%v'", pairID)
 	}
-	nonce, err := nonceSetter.GetPoolNonce(tokenCfg.DcrmAddress, "latest")
+	signer := tokenCfg.GetSwapSigner(res.SwapFrom)
+	if !strings.EqualFold(signer, tokenCfg.DcrmAddress) && !tokenCfg.IsRetiringDcrmAddress(signer) {
+		return nil, nil, fmt.Errorf("swap signer %v is neither active nor retiring dcrm address", signer)
+	}
+	nonce, err := nonceSetter.GetPoolNonce(signer, "latest")
 	if err != nil {
 		return nil, nil, errGetNonceFailed
 	}
@@ -113,7 +118,7 @@ func replaceSwap(txid, pairID, bind, gasPriceStr string, isSwapin bool) (txHash
 			TxType:     tokens.SwapTxType(swap.TxType),
 			Bind:       bind,
 		},
-		From:        tokenCfg.DcrmAddress,
+		From:        tokenCfg.GetSwapSigner(res.SwapFrom),
 		OriginValue: value,
 		Extra: &tokens.AllExtras{
 			EthExtra: &tokens.EthExtraArgs{
@@ -122,13 +127,19 @@ func replaceSwap(txid, pairID, bind, gasPriceStr string, isSwapin bool) (txHash
 			},
 		},
 	}
+	if args.TxType == tokens.ManualPayoutTx {
+		err = setManualPayoutSwapInfo(args)
+		if err != nil {
+			return "", err
+		}
+	}
 	rawTx, err := bridge.BuildRawTransaction(args)
 	if err != nil {
 		logWorkerError("replaceSwap", "build tx failed", err, "txid", txid, "bind", bind, "isSwapin", isSwapin)
 		return "", errBuildTxFailed
 	}
 	var signedTx interface{}
-	if tokenCfg.GetDcrmAddressPrivateKey() != nil {
+	if tokenCfg.GetDcrmAddressPrivateKey() != nil && !tokenCfg.IsRetiringDcrmAddress(args.From) {
 		signedTx, txHash, err = bridge.SignTransaction(rawTx, pairID)
 	} else {
 		signedTx, txHash, err = bridge.DcrmSignTransaction(rawTx, args.GetExtraArgs())
@@ -4,6 +4,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/anyswap/CrossChain-Bridge/internal/metrics"
 	"github.com/anyswap/CrossChain-Bridge/mongodb"
 	"github.com/anyswap/CrossChain-Bridge/tokens"
 	"github.com/anyswap/CrossChain-Bridge/types"
@@ -24,6 +25,8 @@ func startSwapinStableJob() {
 	swapinStableStarter.Do(func() {
 		logWorker("stable", "start update swapin stable job")
 		for {
+			start := time.Now()
+			markJobAlive("swapin_stable")
 			res, err := findSwapinResultsToStable()
 			if err != nil {
 				logWorkerError("stable", "find swapin results error", err)
@@ -38,6 +41,7 @@ func startSwapinStableJob() {
 				}
 				time.Sleep(3 * time.Second) // in case of too frequently rpc calling
 			}
+			metrics.ObserveJobLoop("swapin_stable", start)
 			restInJob(restIntervalInStableJob)
 		}
 	})
@@ -47,6 +51,8 @@ func startSwapoutStableJob() {
 	swapoutStableStarter.Do(func() {
 		logWorker("stable", "start update swapout stable job")
 		for {
+			start := time.Now()
+			markJobAlive("swapout_stable")
 			res, err := findSwapoutResultsToStable()
 			if err != nil {
 				logWorkerError("stable", "find swapout results error", err)
@@ -60,6 +66,7 @@ func startSwapoutStableJob() {
 					logWorkerError("stable", "process swapout stable error", err)
 				}
 			}
+			metrics.ObserveJobLoop("swapout_stable", start)
 			restInJob(restIntervalInStableJob)
 		}
 	})
@@ -4,17 +4,29 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/anyswap/CrossChain-Bridge/common"
+	"github.com/anyswap/CrossChain-Bridge/dcrm"
+	"github.com/anyswap/CrossChain-Bridge/internal/metrics"
 	"github.com/anyswap/CrossChain-Bridge/mongodb"
 	"github.com/anyswap/CrossChain-Bridge/params"
 	"github.com/anyswap/CrossChain-Bridge/tokens"
 )
 
 var (
-	swapChanSize       = 10
-	swapinTaskChanMap  = make(map[string]chan *tokens.BuildTxArgs)
-	swapoutTaskChanMap = make(map[string]chan *tokens.BuildTxArgs)
+	swapChanSize = 10
+
+	// key is dcrm address, written when token pair is added at runtime
+	swapinTaskChanMap   = make(map[string]chan *tokens.BuildTxArgs)
+	swapoutTaskChanMap  = make(map[string]chan *tokens.BuildTxArgs)
+	swapTaskChanMapLock sync.RWMutex
+
+	// key is pairID, closed to stop the swap jobs of the pair
+	swapJobStopChans     = make(map[string]chan struct{})
+	swapJobStopChansLock sync.Mutex
+	isSwapJobStarted     bool
 
 	errAlreadySwapped = errors.New("already swapped")
 )
@@ -28,6 +40,9 @@ func StartSwapJob() {
 	if nonceSetter, ok := tokens.SrcBridge.(tokens.NonceSetter); ok {
 		nonceSetter.InitNonces(swapoutNonces)
 	}
+	swapJobStopChansLock.Lock()
+	isSwapJobStarted = true
+	swapJobStopChansLock.Unlock()
 	for _, pairCfg := range tokens.GetTokenPairsConfig() {
 		AddSwapJob(pairCfg)
 	}
@@ -36,24 +51,83 @@ func StartSwapJob() {
 // AddSwapJob add swap job
 func AddSwapJob(pairCfg *tokens.TokenPairConfig) {
 	pairID := strings.ToLower(pairCfg.PairID)
-	swapinDcrmAddr := strings.ToLower(pairCfg.DestToken.DcrmAddress)
-	if _, exist := swapinTaskChanMap[swapinDcrmAddr]; !exist {
-		swapinTaskChanMap[swapinDcrmAddr] = make(chan *tokens.BuildTxArgs, swapChanSize)
-		go processSwapTask(swapinTaskChanMap[swapinDcrmAddr])
+	addSwapTaskChan(swapinTaskChanMap, pairCfg.DestToken.DcrmAddress)
+	addSwapTaskChan(swapoutTaskChanMap, pairCfg.SrcToken.DcrmAddress)
+
+	swapJobStopChansLock.Lock()
+	defer swapJobStopChansLock.Unlock()
+	if _, exist := swapJobStopChans[pairID]; exist {
+		logWorker("swap", "swap job already exist", "pairID", pairID)
+		return
+	}
+	stopCh := make(chan struct{})
+	swapJobStopChans[pairID] = stopCh
+
+	go startSwapinSwapJob(pairID, stopCh)
+	go startSwapoutSwapJob(pairID, stopCh)
+}
+
+// start swap task processor of dcrm address if not exist
+func addSwapTaskChan(chanMap map[string]chan *tokens.BuildTxArgs, dcrmAddress string) {
+	dcrmAddress = strings.ToLower(dcrmAddress)
+	swapTaskChanMapLock.Lock()
+	defer swapTaskChanMapLock.Unlock()
+	if _, exist := chanMap[dcrmAddress]; !exist {
+		swapChan := make(chan *tokens.BuildTxArgs, swapChanSize)
+		chanMap[dcrmAddress] = swapChan
+		go processSwapTask(swapChan)
+	}
+}
+
+func getSwapTaskChan(isSwapin bool, dcrmAddress string) (swapChan chan *tokens.BuildTxArgs, exist bool) {
+	dcrmAddress = strings.ToLower(dcrmAddress)
+	swapTaskChanMapLock.RLock()
+	defer swapTaskChanMapLock.RUnlock()
+	if isSwapin {
+		swapChan, exist = swapinTaskChanMap[dcrmAddress]
+	} else {
+		swapChan, exist = swapoutTaskChanMap[dcrmAddress]
 	}
-	swapoutDcrmAddr := strings.ToLower(pairCfg.SrcToken.DcrmAddress)
-	if _, exist := swapoutTaskChanMap[swapoutDcrmAddr]; !exist {
-		swapoutTaskChanMap[swapoutDcrmAddr] = make(chan *tokens.BuildTxArgs, swapChanSize)
-		go processSwapTask(swapoutTaskChanMap[swapoutDcrmAddr])
+	return swapChan, exist
+}
+
+// RemoveSwapJob stop swap jobs of token pair,
+// the jobs exit after finishing the current round
+func RemoveSwapJob(pairID string) {
+	pairID = strings.ToLower(pairID)
+	swapJobStopChansLock.Lock()
+	defer swapJobStopChansLock.Unlock()
+	if stopCh, exist := swapJobStopChans[pairID]; exist {
+		close(stopCh)
+		delete(swapJobStopChans, pairID)
+		logWorker("swap", "remove swap job", "pairID", pairID)
 	}
+}
 
-	go startSwapinSwapJob(pairID)
-	go startSwapoutSwapJob(pairID)
+func isSwapJobStopped(pairID, job string, stopCh chan struct{}) bool {
+	select {
+	case <-stopCh:
+	default:
+		return false
+	}
+	swapJobStopChansLock.Lock()
+	defer swapJobStopChansLock.Unlock()
+	// keep heartbeat if the pair is added again with new job
+	if _, exist := swapJobStopChans[pairID]; !exist {
+		removeJobHeartbeat(job + "/" + pairID)
+	}
+	logWorker("swap", "stop swap job", "job", job, "pairID", pairID)
+	return true
 }
 
-func startSwapinSwapJob(pairID string) {
+func startSwapinSwapJob(pairID string, stopCh chan struct{}) {
 	logWorker("swap", "start swapin swap job")
 	for {
+		if isSwapJobStopped(pairID, "swapin_swap", stopCh) {
+			return
+		}
+		start := time.Now()
+		markJobAlive("swapin_swap/" + pairID)
 		res, err := findSwapinsToSwap(pairID)
 		if err != nil {
 			logWorkerError("swapin", "find swapins error", err)
@@ -69,13 +143,19 @@ func startSwapinSwapJob(pairID string) {
 				logWorkerError("swapin", "process swapin swap error", err, "pairID", swap.PairID, "txid", swap.TxID, "bind", swap.Bind)
 			}
 		}
+		metrics.ObserveJobLoop("swapin_swap", start)
 		restInJob(restIntervalInDoSwapJob)
 	}
 }
 
-func startSwapoutSwapJob(pairID string) {
+func startSwapoutSwapJob(pairID string, stopCh chan struct{}) {
 	logWorker("swapout", "start swapout swap job")
 	for {
+		if isSwapJobStopped(pairID, "swapout_swap", stopCh) {
+			return
+		}
+		start := time.Now()
+		markJobAlive("swapout_swap/" + pairID)
 		res, err := findSwapoutsToSwap(pairID)
 		if err != nil {
 			logWorkerError("swapout", "find swapouts error", err)
@@ -91,6 +171,7 @@ func startSwapoutSwapJob(pairID string) {
 				logWorkerError("swapout", "process swapout swap error", err, "pairID", swap.PairID, "txid", swap.TxID, "bind", swap.Bind)
 			}
 		}
+		metrics.ObserveJobLoop("swapout_swap", start)
 		restInJob(restIntervalInDoSwapJob)
 	}
 }
@@ -144,6 +225,11 @@ func processSwap(swap *mongodb.MgoSwap, isSwapin bool) (err error) {
 		return err
 	}
 
+	err = preventSwapOfPaidOut(swap, isSwapin)
+	if err != nil {
+		return err
+	}
+
 	logWorker("swap", "start process swap", "pairID", pairID, "txid", txid, "bind", bind, "status", swap.Status, "isSwapin", isSwapin, "value", res.Value)
 
 	fromTokenCfg, toTokenCfg := tokens.GetTokenConfigsByDirection(pairID, isSwapin)
@@ -151,10 +237,14 @@ func processSwap(swap *mongodb.MgoSwap, isSwapin bool) (err error) {
 		logWorkerTrace("swap", "swap is not configed", "pairID", pairID, "isSwapin", isSwapin)
 		return nil
 	}
-	if fromTokenCfg.DisableSwap {
+	if fromTokenCfg.IsSwapDisabled() {
 		logWorkerTrace("swap", "swap is disabled", "pairID", pairID, "isSwapin", isSwapin)
 		return nil
 	}
+	if window := fromTokenCfg.GetActiveMaintenanceWindow(); window != nil {
+		logWorkerTrace("swap", "swap is in maintenance", "pairID", pairID, "isSwapin", isSwapin, "window", window.ID)
+		return nil
+	}
 	isBlacked, err := isSwapInBlacklist(res)
 	if err != nil {
 		return err
@@ -184,6 +274,12 @@ func processSwap(swap *mongodb.MgoSwap, isSwapin bool) (err error) {
 		From:        toTokenCfg.DcrmAddress,
 		OriginValue: value,
 	}
+	if args.TxType == tokens.ManualPayoutTx {
+		err = setManualPayoutSwapInfo(args)
+		if err != nil {
+			return err
+		}
+	}
 
 	return dispatchSwapTask(args)
 }
@@ -196,6 +292,23 @@ func preventDoubleSwap(res *mongodb.MgoSwapResult, isSwapin bool) error {
 	return nil
 }
 
+// swap of original tx which is manual paid out should not be swapped again
+func preventSwapOfPaidOut(swap *mongodb.MgoSwap, isSwapin bool) error {
+	if tokens.SwapTxType(swap.TxType) == tokens.ManualPayoutTx {
+		return nil
+	}
+	_, err := mongodb.FindManualPayout(swap.TxID, swap.PairID)
+	switch err {
+	case nil:
+		_ = mongodb.UpdateSwapStatus(isSwapin, swap.TxID, swap.PairID, swap.Bind, mongodb.TxProcessed, now(), errPaidOut.Error())
+		return errAlreadySwapped
+	case mongodb.ErrItemNotFound:
+		return nil
+	default:
+		return err
+	}
+}
+
 func getSwapType(isSwapin bool) tokens.SwapType {
 	if isSwapin {
 		return tokens.SwapinType
@@ -204,16 +317,15 @@ func getSwapType(isSwapin bool) tokens.SwapType {
 }
 
 func dispatchSwapTask(args *tokens.BuildTxArgs) error {
-	from := strings.ToLower(args.From)
 	switch args.SwapType {
 	case tokens.SwapinType:
-		swapChan, exist := swapinTaskChanMap[from]
+		swapChan, exist := getSwapTaskChan(true, args.From)
 		if !exist {
 			return fmt.Errorf("no swapin task channel for dcrm address '%v'", args.From)
 		}
 		swapChan <- args
 	case tokens.SwapoutType:
-		swapChan, exist := swapoutTaskChanMap[from]
+		swapChan, exist := getSwapTaskChan(false, args.From)
 		if !exist {
 			return fmt.Errorf("no swapout task channel for dcrm address '%v'", args.From)
 		}
@@ -228,6 +340,22 @@ func dispatchSwapTask(args *tokens.BuildTxArgs) error {
 func processSwapTask(swapChan <-chan *tokens.BuildTxArgs) {
 	for {
 		args := <-swapChan
+		batch := []*tokens.BuildTxArgs{args}
+		// collect ready swaps of the same dcrm address to sign in one round
+		batchSize := params.GetSwapBatchSize()
+	COLLECT_LOOP:
+		for len(batch) < batchSize {
+			select {
+			case more := <-swapChan:
+				batch = append(batch, more)
+			default:
+				break COLLECT_LOOP
+			}
+		}
+		if len(batch) > 1 {
+			doSwapBatch(batch)
+			continue
+		}
 		err := doSwap(args)
 		switch err {
 		case nil, errAlreadySwapped:
@@ -238,53 +366,175 @@ func processSwapTask(swapChan <-chan *tokens.BuildTxArgs) {
 }
 
 func doSwap(args *tokens.BuildTxArgs) (err error) {
+	resBridge, rawTx, err := buildSwapTx(args)
+	if err != nil {
+		return err
+	}
+
 	pairID := args.PairID
 	txid := args.SwapID
 	bind := args.Bind
-	swapType := args.SwapType
-	originValue := args.OriginValue
+	isSwapin := args.SwapType == tokens.SwapinType
 
-	isSwapin := swapType == tokens.SwapinType
+	var signedTx interface{}
+	var txHash string
+	tokenCfg := resBridge.GetTokenConfig(pairID)
+	if tokenCfg == nil { // pair is removed
+		return tokens.ErrUnknownPairID
+	}
+	if tokenCfg.GetDcrmAddressPrivateKey() != nil {
+		signedTx, txHash, err = resBridge.SignTransaction(rawTx, pairID)
+	} else {
+		signedTx, txHash, err = resBridge.DcrmSignTransaction(rawTx, args.GetExtraArgs())
+	}
+	if err != nil {
+		logWorkerError("doSwap", "sign tx failed", err, "txid", txid, "bind", bind, "isSwapin", isSwapin)
+		markSwapWithBadSignature(args, err)
+		return err
+	}
+
+	return sendSwapTx(resBridge, args, signedTx, txHash)
+}
+
+// doSwapBatch sign swaps in one dcrm sign round if the bridge supports it,
+// otherwise process them one by one.
+func doSwapBatch(batch []*tokens.BuildTxArgs) {
+	isSwapin := batch[0].SwapType == tokens.SwapinType
 	resBridge := tokens.GetCrossChainBridge(!isSwapin)
+	batchSigner, ok := resBridge.(tokens.BatchDcrmSigner)
+	tokenCfg := resBridge.GetTokenConfig(batch[0].PairID)
+	if !ok || tokenCfg == nil || tokenCfg.GetDcrmAddressPrivateKey() != nil {
+		for _, args := range batch {
+			err := doSwap(args)
+			switch err {
+			case nil, errAlreadySwapped:
+			default:
+				logWorkerError("doSwap", "process failed", err, "pairID", args.PairID, "txid", args.SwapID, "swapType", args.SwapType.String(), "value", args.OriginValue)
+			}
+		}
+		return
+	}
+
+	_, isNonceSetter := resBridge.(tokens.NonceSetter)
+	var (
+		nextNonce  *uint64
+		argsList   []*tokens.BuildTxArgs
+		rawTxs     []interface{}
+		extraArgss []*tokens.BuildTxArgs
+		processed  = make(map[string]struct{})
+	)
+	for _, args := range batch {
+		// the same swap may be dispatched more than once before it is processed
+		key := strings.ToLower(args.PairID + ":" + args.SwapID + ":" + args.Bind)
+		if _, exist := processed[key]; exist {
+			continue
+		}
+		processed[key] = struct{}{}
+		if isNonceSetter && nextNonce != nil {
+			// following txs use successive nonces
+			nonce := *nextNonce
+			if args.Extra == nil {
+				args.Extra = &tokens.AllExtras{}
+			}
+			if args.Extra.EthExtra == nil {
+				args.Extra.EthExtra = &tokens.EthExtraArgs{}
+			}
+			args.Extra.EthExtra.Nonce = &nonce
+		}
+		_, rawTx, err := buildSwapTx(args)
+		if err != nil {
+			if err != errAlreadySwapped {
+				logWorkerError("doSwap", "process failed", err, "pairID", args.PairID, "txid", args.SwapID, "swapType", args.SwapType.String(), "value", args.OriginValue)
+			}
+			continue
+		}
+		nonce := args.GetTxNonce() + 1
+		nextNonce = &nonce
+		argsList = append(argsList, args)
+		rawTxs = append(rawTxs, rawTx)
+		extraArgss = append(extraArgss, args.GetExtraArgs())
+	}
+	if len(rawTxs) == 0 {
+		return
+	}
+
+	logWorker("doSwap", "start batch sign", "count", len(rawTxs), "isSwapin", isSwapin)
+	signedTxs, txHashes, err := batchSigner.DcrmSignTransactions(rawTxs, extraArgss)
+	if err != nil {
+		logWorkerError("doSwap", "batch sign tx failed", err, "count", len(rawTxs), "isSwapin", isSwapin)
+		markBatchSwapsWithBadSignature(argsList, err)
+		return
+	}
+
+	for i, args := range argsList {
+		err = sendSwapTx(resBridge, args, signedTxs[i], txHashes[i])
+		if err != nil {
+			// stop to prevent nonce gap, the remaining swaps are processed in next round
+			logWorkerError("doSwap", "process failed", err, "pairID", args.PairID, "txid", args.SwapID, "swapType", args.SwapType.String(), "value", args.OriginValue, "remaining", len(argsList)-i-1)
+			break
+		}
+	}
+}
+
+// only mark swaps of the bad signatures, others are retried in next round
+func markBatchSwapsWithBadSignature(argsList []*tokens.BuildTxArgs, err error) {
+	var badSigErr *dcrm.BadSignaturesError
+	if !errors.As(err, &badSigErr) {
+		for _, args := range argsList {
+			markSwapWithBadSignature(args, err)
+		}
+		return
+	}
+	for _, i := range badSigErr.Indexes {
+		if i < len(argsList) {
+			markSwapWithBadSignature(argsList[i], err)
+		}
+	}
+}
+
+func buildSwapTx(args *tokens.BuildTxArgs) (resBridge tokens.CrossChainBridge, rawTx interface{}, err error) {
+	pairID := args.PairID
+	txid := args.SwapID
+	bind := args.Bind
+	originValue := args.OriginValue
+
+	isSwapin := args.SwapType == tokens.SwapinType
+	resBridge = tokens.GetCrossChainBridge(!isSwapin)
 
 	res, err := mongodb.FindSwapResult(isSwapin, txid, pairID, bind)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 	err = preventDoubleSwap(res, isSwapin)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	logWorker("doSwap", "start to process", "pairID", pairID, "txid", txid, "bind", bind, "isSwapin", isSwapin, "value", originValue)
 
-	rawTx, err := resBridge.BuildRawTransaction(args)
+	rawTx, err = resBridge.BuildRawTransaction(args)
 	if err != nil {
 		logWorkerError("doSwap", "build tx failed", err, "txid", txid, "bind", bind, "isSwapin", isSwapin)
-		return err
+		return nil, nil, err
 	}
+	return resBridge, rawTx, nil
+}
 
+func sendSwapTx(resBridge tokens.CrossChainBridge, args *tokens.BuildTxArgs, signedTx interface{}, txHash string) (err error) {
+	pairID := args.PairID
+	txid := args.SwapID
+	bind := args.Bind
+	swapType := args.SwapType
+	isSwapin := swapType == tokens.SwapinType
 	swapNonce := args.GetTxNonce()
 
-	var signedTx interface{}
-	var txHash string
-	tokenCfg := resBridge.GetTokenConfig(pairID)
-	if tokenCfg.GetDcrmAddressPrivateKey() != nil {
-		signedTx, txHash, err = resBridge.SignTransaction(rawTx, pairID)
-	} else {
-		signedTx, txHash, err = resBridge.DcrmSignTransaction(rawTx, args.GetExtraArgs())
-	}
-	if err != nil {
-		logWorkerError("doSwap", "sign tx failed", err, "txid", txid, "bind", bind, "isSwapin", isSwapin)
-		return err
-	}
-
 	// update database before sending transaction
 	matchTx := &MatchTx{
 		SwapTx:    txHash,
-		SwapValue: tokens.CalcSwappedValue(pairID, originValue, isSwapin).String(),
+		SwapValue: tokens.CalcSwappedValue(pairID, args.OriginValue, isSwapin).String(),
 		SwapType:  swapType,
 		SwapNonce: swapNonce,
+		SwapFrom:  args.From,
 	}
 	err = updateSwapResult(txid, pairID, bind, matchTx)
 	if err != nil {
@@ -302,7 +552,17 @@ func doSwap(args *tokens.BuildTxArgs) (err error) {
 	if err == nil {
 		if nonceSetter, ok := resBridge.(tokens.NonceSetter); ok {
 			nonceSetter.SetNonce(pairID, swapNonce+1) // increase for next usage
+			metrics.SetSwapNonce(pairID, isSwapin, swapNonce+1)
 		}
 	}
 	return err
 }
+
+// dcrm returns bad signature, stop retrying and wait for manual process
+func markSwapWithBadSignature(args *tokens.BuildTxArgs, err error) {
+	if !errors.Is(err, dcrm.ErrBadSignature) {
+		return
+	}
+	isSwapin := args.SwapType == tokens.SwapinType
+	_ = mongodb.UpdateSwapStatus(isSwapin, args.SwapID, args.PairID, args.Bind, mongodb.SwapWithBadSignature, now(), err.Error())
+}
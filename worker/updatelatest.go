@@ -18,6 +18,7 @@ func StartUpdateLatestBlockHeightJob() {
 
 func adjustGatewayOrder() {
 	for {
+		markJobAlive("adjust_gateway")
 		logWorker("adjustGatewayOrder", "adjust gateway api adddress order")
 		adjustGatewayOrderImpl(true)
 		adjustGatewayOrderImpl(false)
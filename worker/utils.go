@@ -3,6 +3,7 @@ package worker
 import (
 	"time"
 
+	"github.com/anyswap/CrossChain-Bridge/internal/health"
 	"github.com/anyswap/CrossChain-Bridge/log"
 )
 
@@ -53,3 +54,11 @@ func getSepTimeInFind(dist int64) int64 {
 func restInJob(duration time.Duration) {
 	time.Sleep(duration)
 }
+
+func markJobAlive(job string) {
+	health.MarkJobAlive(job)
+}
+
+func removeJobHeartbeat(job string) {
+	health.RemoveJobHeartbeat(job)
+}
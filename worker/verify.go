@@ -2,7 +2,9 @@ package worker
 
 import (
 	"sync"
+	"time"
 
+	"github.com/anyswap/CrossChain-Bridge/internal/metrics"
 	"github.com/anyswap/CrossChain-Bridge/mongodb"
 	"github.com/anyswap/CrossChain-Bridge/tokens"
 )
@@ -22,6 +24,8 @@ func startSwapinVerifyJob() {
 	swapinVerifyStarter.Do(func() {
 		logWorker("verify", "start swapin verify job")
 		for {
+			start := time.Now()
+			markJobAlive("swapin_verify")
 			res, err := findSwapinsToVerify()
 			if err != nil {
 				logWorkerError("verify", "find swapins error", err)
@@ -37,6 +41,7 @@ func startSwapinVerifyJob() {
 					logWorkerError("verify", "process swapin verify error", err, "txid", swap.TxID)
 				}
 			}
+			metrics.ObserveJobLoop("swapin_verify", start)
 			restInJob(restIntervalInVerifyJob)
 		}
 	})
@@ -46,6 +51,8 @@ func startSwapoutVerifyJob() {
 	swapoutVerifyStarter.Do(func() {
 		logWorker("verify", "start swapout verify job")
 		for {
+			start := time.Now()
+			markJobAlive("swapout_verify")
 			res, err := findSwapoutsToVerify()
 			if err != nil {
 				logWorkerError("verify", "find swapouts error", err)
@@ -61,6 +68,7 @@ func startSwapoutVerifyJob() {
 					logWorkerError("verify", "process swapout verify error", err, "txid", swap.TxID)
 				}
 			}
+			metrics.ObserveJobLoop("swapout_verify", start)
 			restInJob(restIntervalInVerifyJob)
 		}
 	})
@@ -106,6 +114,11 @@ func processSwapVerify(swap *mongodb.MgoSwap, isSwapin bool) (err error) {
 	bind := swap.Bind
 	bridge := tokens.GetCrossChainBridge(isSwapin)
 
+	// manual payout is verified when created by admin, and has no swap tx to verify
+	if tokens.SwapTxType(swap.TxType) == tokens.ManualPayoutTx {
+		return mongodb.UpdateSwapStatus(isSwapin, txid, pairID, bind, mongodb.TxNotSwapped, now(), "")
+	}
+
 	swapInfo, err := verifySwapTransaction(bridge, pairID, txid, bind, tokens.SwapTxType(swap.TxType))
 	if swapInfo == nil {
 		return err
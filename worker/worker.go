@@ -29,6 +29,17 @@ func StartWork(isServer bool) {
 		return
 	}
 
+	LoadPairStates()
+	go StartSyncPairStatesJob()
+	time.Sleep(interval)
+
+	LoadMaintenanceWindows()
+	go StartMaintenanceJob()
+	time.Sleep(interval)
+
+	go StartBlacklistJob()
+	time.Sleep(interval)
+
 	go StartVerifyJob()
 	time.Sleep(interval)
 
@@ -42,4 +53,10 @@ func StartWork(isServer bool) {
 	time.Sleep(interval)
 
 	go StartAggregateJob()
+	time.Sleep(interval)
+
+	go StartMigrateJob()
+	time.Sleep(interval)
+
+	go StartSwapMetricsJob()
 }